@@ -3,6 +3,7 @@ package api
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -21,6 +22,29 @@ type APIClient struct {
 	t          *testing.T
 	logCtx     *context.Context
 	mu         sync.Mutex
+
+	// When set, resources check that an item hasn't changed since it was last
+	// read before updating or deleting it
+	OptimisticConcurrency bool
+	// When set, creating an item adopts an existing matching item instead,
+	// unless the resource says otherwise
+	AdoptExisting bool
+}
+
+// Returned when the appliance responds with an error status
+type APIError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("status: %d, body: %s", e.StatusCode, e.Body)
+}
+
+// Reports whether err is the appliance saying the requested item doesn't exist
+func IsNotFound(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
 }
 
 func (c *APIClient) SetTest(t *testing.T) {
@@ -130,7 +154,7 @@ func (c *APIClient) doRequest(req *http.Request) ([]byte, error) {
 	}
 
 	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusMultipleChoices {
-		return nil, fmt.Errorf("status: %d, body: %s", res.StatusCode, body)
+		return nil, &APIError{StatusCode: res.StatusCode, Body: string(body)}
 	}
 
 	if res.StatusCode == http.StatusNoContent {
@@ -84,6 +84,10 @@ func TestDoRequest(t *testing.T) {
 				w.WriteHeader(http.StatusTeapot)
 				_, err := w.Write([]byte(errorString))
 				assert.Nil(t, err)
+			} else if strings.HasSuffix(r.URL.Path, "missing") {
+				w.WriteHeader(http.StatusNotFound)
+				_, err := w.Write([]byte(errorString))
+				assert.Nil(t, err)
 			} else if strings.HasSuffix(r.URL.Path, "no-content") {
 				w.WriteHeader(http.StatusNoContent)
 				_, err := w.Write([]byte(""))
@@ -119,6 +123,15 @@ func TestDoRequest(t *testing.T) {
 		body, err := c.doRequest(req)
 		assert.Nil(t, body)
 		assert.Equal(t, fmt.Sprintf("status: %d, body: %s", http.StatusTeapot, errorString), err.Error())
+		assert.False(t, IsNotFound(err))
+	}
+
+	{
+		req, err := http.NewRequest("GET", fmt.Sprintf("%s/%s", c.RootURL, "missing"), nil)
+		assert.Nil(t, err)
+		_, err = c.doRequest(req)
+		assert.True(t, IsNotFound(err))
+		assert.True(t, IsNotFound(fmt.Errorf("wrapped: %w", err)))
 	}
 
 	{
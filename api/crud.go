@@ -1,10 +1,12 @@
 package api
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"reflect"
+	"slices"
 	"strings"
 )
 
@@ -57,7 +59,11 @@ func Post[I APIResource](c *APIClient, path string, item I, ignoreReturn bool) (
 
 func ListItems[I APIResource](c *APIClient, query ...map[string]string) ([]I, error) {
 	var tmp I
-	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/%s", c.BaseURL, tmp.Endpoint()), nil)
+	return ListItemsEndpoint[I](c, tmp.Endpoint(), query...)
+}
+
+func ListItemsEndpoint[I APIResource](c *APIClient, endpoint string, query ...map[string]string) ([]I, error) {
+	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/%s", c.BaseURL, endpoint), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -155,7 +161,91 @@ func UpdateItem[I APIResource](c *APIClient, item I) (*I, error) {
 	return UpdateItemEndpoint(c, item, endpoint)
 }
 func UpdateItemEndpoint[I APIResource](c *APIClient, item I, endpoint string) (*I, error) {
-	rb, err := json.Marshal(item)
+	return patchEndpoint[I](c, endpoint, item)
+}
+
+// Updates only the fields of the item that changed from its prior version, so
+// fields the provider doesn't manage keep the values set elsewhere. When nothing
+// changed, the item is read instead, so the result is always current
+func UpdateChangedFields[I APIResource](c *APIClient, item I, prior I) (*I, error) {
+	fields, err := ChangedFields(item, prior)
+	if err != nil {
+		return nil, err
+	}
+
+	return UpdateFields(c, item, fields)
+}
+
+// Updates the given fields of the item, as returned by ChangedFields, for callers
+// that already have them. When there are none, the item is read instead
+func UpdateFields[I APIResource](c *APIClient, item I, fields map[string]json.RawMessage) (*I, error) {
+	itemObj := reflect.ValueOf(item)
+	id := itemObj.FieldByName("ID").Elem().Int()
+	endpoint := fmt.Sprintf("%s/%d", item.Endpoint(), id)
+
+	if len(fields) == 0 {
+		c.LogString("✅ UpdateFields has nothing to change for %s", endpoint)
+		return GetItemEndpoint[I](c, endpoint)
+	}
+
+	return patchEndpoint[I](c, endpoint, fields)
+}
+
+// Returns the top level JSON fields of the item whose values differ from the
+// prior version. Fields the item leaves out with omitempty are never included,
+// just like when the whole item is sent
+func ChangedFields[I any](item I, prior I) (map[string]json.RawMessage, error) {
+	diff, err := DiffFields(item, prior)
+	if err != nil {
+		return nil, err
+	}
+
+	changed := map[string]json.RawMessage{}
+	for _, d := range diff {
+		changed[d.Name] = d.Value
+	}
+	return changed, nil
+}
+
+// A top level JSON field whose value differs between two versions of an item.
+// Prior is nil when the prior version doesn't have the field
+type FieldDiff struct {
+	Name  string
+	Prior json.RawMessage
+	Value json.RawMessage
+}
+
+// Returns the differences between the item and its prior version, sorted by
+// field name. Only the fields in the item's JSON are compared, see ChangedFields
+func DiffFields[I any](item I, prior I) ([]FieldDiff, error) {
+	var itemFields, priorFields map[string]json.RawMessage
+	for _, v := range []struct {
+		from any
+		to   *map[string]json.RawMessage
+	}{{item, &itemFields}, {prior, &priorFields}} {
+		rb, err := json.Marshal(v.from)
+		if err != nil {
+			return nil, err
+		}
+		if err = json.Unmarshal(rb, v.to); err != nil {
+			return nil, err
+		}
+	}
+
+	diff := []FieldDiff{}
+	for name, value := range itemFields {
+		if priorValue, ok := priorFields[name]; !ok || !bytes.Equal(value, priorValue) {
+			diff = append(diff, FieldDiff{Name: name, Prior: priorValue, Value: value})
+		}
+	}
+	slices.SortFunc(diff, func(a, b FieldDiff) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return diff, nil
+}
+
+func patchEndpoint[I APIResource](c *APIClient, endpoint string, body any) (*I, error) {
+	rb, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
@@ -165,13 +255,13 @@ func UpdateItemEndpoint[I APIResource](c *APIClient, item I, endpoint string) (*
 		return nil, err
 	}
 
-	body, err := c.doRequest(req)
+	respBody, err := c.doRequest(req)
 	if err != nil {
 		return nil, err
 	}
 
 	var newItem I
-	err = json.Unmarshal(body, &newItem)
+	err = json.Unmarshal(respBody, &newItem)
 	if err != nil {
 		return nil, err
 	}
@@ -197,3 +287,59 @@ func DeleteItemEndpoint[I APIResource](c *APIClient, endpoint string) error {
 	_, err = c.doRequest(req)
 	return err
 }
+
+// Deletes using the item as the request body, for endpoints that identify what
+// to delete by the body instead of the URL
+func DeleteItemBody[I APIResource](c *APIClient, item I) error {
+	rb, err := json.Marshal(item)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodDelete, fmt.Sprintf("%s/%s", c.BaseURL, item.Endpoint()), strings.NewReader(string(rb)))
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	return err
+}
+
+// CopyJumpItem is the body of a request to copy a Jump Item
+type CopyJumpItem struct {
+	JumpGroupID   int    `json:"jump_group_id"`
+	JumpGroupType string `json:"jump_group_type"`
+	Name          string `json:"name"`
+}
+
+// Copies the Jump Item with the given ID using its copy endpoint, returning the ID of the copy
+func CopyItem[I APIResource](c *APIClient, id int, body CopyJumpItem) (int, error) {
+	var tmp I
+	rb, err := json.Marshal(body)
+	if err != nil {
+		return 0, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/%s/%d/copy", c.BaseURL, tmp.Endpoint(), id), strings.NewReader(string(rb)))
+	if err != nil {
+		return 0, err
+	}
+
+	respBody, err := c.doRequest(req)
+	if err != nil {
+		return 0, err
+	}
+
+	var result struct {
+		DestID *int `json:"destId"`
+	}
+	err = json.Unmarshal(respBody, &result)
+	if err != nil {
+		return 0, err
+	}
+
+	if result.DestID != nil {
+		return *result.DestID, nil
+	}
+	return 0, fmt.Errorf("no ID for the copy in the response: %s", string(respBody))
+}
@@ -1,7 +1,9 @@
 package api
 
 import (
+	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -164,6 +166,56 @@ func TestListItems(t *testing.T) {
 	}
 }
 
+func TestListItemsEndpoint(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "oauth2/token") {
+			w.Header().Set("Content-Type", "application/json")
+			_, err := w.Write([]byte(`{"token_type":"Bearer","expires_in":3600,"access_token":"secret_access_granted"}`))
+			assert.Nil(t, err)
+		} else {
+			assert.Equal(t, "SRA-Terraform-Plugin", r.Header.Get("User-Agent"))
+			assert.Equal(t, "application/json", r.Header.Get("Accept"))
+			w.Header().Set("Content-Type", "application/json")
+
+			if r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "test-resource/1/children") {
+				w.WriteHeader(http.StatusOK)
+				var err error
+				if r.URL.Query().Get("name") == "Cosette" {
+					_, err = w.Write([]byte(`[{"Location":"the_apartment"}]`))
+				} else {
+					_, err = w.Write([]byte(`[{"Location":"the_sewers"},{"Location":"the_barricade"}]`))
+				}
+				assert.Nil(t, err)
+			} else {
+				assert.Fail(t, "Bad request", r.URL)
+			}
+		}
+	}))
+	defer ts.Close()
+
+	clientID := "id"
+	clientSecret := "🤐"
+	c, err := NewClient(ts.URL, &clientID, &clientSecret)
+	c.SetTest(t)
+	assert.Nil(t, err)
+
+	{
+		resp, err := ListItemsEndpoint[testAPIResource](c, "test-resource/1/children")
+		assert.Nil(t, err)
+		assert.Len(t, resp, 2)
+		assert.Equal(t, "the_sewers", resp[0].Location)
+	}
+
+	{
+		resp, err := ListItemsEndpoint[testAPIResource](c, "test-resource/1/children", map[string]string{"name": "Cosette"})
+		assert.Nil(t, err)
+		assert.Len(t, resp, 1)
+		assert.Equal(t, "the_apartment", resp[0].Location)
+	}
+}
+
 func TestGetItem(t *testing.T) {
 	t.Parallel()
 
@@ -523,3 +575,145 @@ func TestDeleteItemEndpoint(t *testing.T) {
 		assert.Equal(t, "status: 400, body: error", err.Error())
 	}
 }
+
+func TestCopyItem(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if strings.HasSuffix(r.URL.Path, "oauth2/token") {
+			_, err := w.Write([]byte(`{"token_type":"Bearer","expires_in":3600,"access_token":"secret_access_granted"}`))
+			assert.Nil(t, err)
+			return
+		}
+
+		assert.Equal(t, http.MethodPost, r.Method)
+		body, err := io.ReadAll(r.Body)
+		assert.Nil(t, err)
+		switch {
+		case strings.HasSuffix(r.URL.Path, "jump-item/shell-jump/4/copy"):
+			assert.JSONEq(t, `{"jump_group_id":2,"jump_group_type":"shared","name":"copy"}`, string(body))
+			_, err = w.Write([]byte(`{"action":"copy","success":"1","destId":9}`))
+		case strings.HasSuffix(r.URL.Path, "jump-item/shell-jump/5/copy"):
+			_, err = w.Write([]byte(`{"action":"copy","success":"0"}`))
+		default:
+			assert.Fail(t, "Bad request", r.URL)
+		}
+		assert.Nil(t, err)
+	}))
+	defer ts.Close()
+
+	clientID := "id"
+	clientSecret := "🤐"
+	c, err := NewClient(ts.URL, &clientID, &clientSecret)
+	c.SetTest(t)
+	assert.Nil(t, err)
+
+	id, err := CopyItem[ShellJump](c, 4, CopyJumpItem{JumpGroupID: 2, JumpGroupType: "shared", Name: "copy"})
+	assert.Nil(t, err)
+	assert.Equal(t, 9, id)
+
+	_, err = CopyItem[ShellJump](c, 5, CopyJumpItem{JumpGroupID: 2, JumpGroupType: "shared", Name: "copy"})
+	assert.ErrorContains(t, err, "no ID for the copy")
+}
+
+func TestChangedFields(t *testing.T) {
+	t.Parallel()
+
+	policy := 3
+	otherPolicy := 4
+	prior := ShellJump{Name: "db", Hostname: "db.local", Port: 22, JumpPolicyID: &policy}
+
+	// Only the changed values are sent
+	item := prior
+	item.Hostname = "db2.local"
+	item.JumpPolicyID = &otherPolicy
+	fields, err := ChangedFields(item, prior)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]json.RawMessage{
+		"hostname":       json.RawMessage(`"db2.local"`),
+		"jump_policy_id": json.RawMessage(`4`),
+	}, fields)
+
+	// Fields left out with omitempty stay out, rather than being cleared
+	item = prior
+	item.JumpPolicyID = nil
+	fields, err = ChangedFields(item, prior)
+	assert.Nil(t, err)
+	assert.Empty(t, fields)
+
+	// But they're sent when they're newly set
+	prior.JumpPolicyID = nil
+	item.JumpPolicyID = &policy
+	fields, err = ChangedFields(item, prior)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]json.RawMessage{"jump_policy_id": json.RawMessage(`3`)}, fields)
+}
+
+func TestDiffFields(t *testing.T) {
+	t.Parallel()
+
+	policy := 3
+	prior := ShellJump{Name: "db", Hostname: "db.local", Port: 22}
+	item := prior
+	item.Port = 2222
+	item.Name = "db2"
+	item.JumpPolicyID = &policy
+
+	diff, err := DiffFields(item, prior)
+	assert.Nil(t, err)
+	assert.Equal(t, []FieldDiff{
+		{Name: "jump_policy_id", Value: json.RawMessage(`3`)},
+		{Name: "name", Prior: json.RawMessage(`"db"`), Value: json.RawMessage(`"db2"`)},
+		{Name: "port", Prior: json.RawMessage(`22`), Value: json.RawMessage(`2222`)},
+	}, diff)
+}
+
+func TestUpdateChangedFields(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if strings.HasSuffix(r.URL.Path, "oauth2/token") {
+			_, err := w.Write([]byte(`{"token_type":"Bearer","expires_in":3600,"access_token":"secret_access_granted"}`))
+			assert.Nil(t, err)
+			return
+		}
+
+		assert.True(t, strings.HasSuffix(r.URL.Path, "test-resource/the_barricade/1"), r.URL.Path)
+		body, err := io.ReadAll(r.Body)
+		assert.Nil(t, err)
+		switch r.Method {
+		case http.MethodPatch:
+			assert.JSONEq(t, `{"Location":"the_barricade"}`, string(body))
+			_, err = w.Write([]byte(`{"Location":"patched"}`))
+		case http.MethodGet:
+			_, err = w.Write([]byte(`{"Location":"unchanged"}`))
+		default:
+			assert.Fail(t, "Bad request", r.URL)
+		}
+		assert.Nil(t, err)
+	}))
+	defer ts.Close()
+
+	clientID := "id"
+	clientSecret := "🤐"
+	c, err := NewClient(ts.URL, &clientID, &clientSecret)
+	c.SetTest(t)
+	assert.Nil(t, err)
+
+	id := 1
+	item := testAPIResource{&id, "the_barricade"}
+
+	resp, err := UpdateChangedFields(c, item, testAPIResource{&id, "the_sewers"})
+	assert.Nil(t, err)
+	assert.Equal(t, "patched", resp.Location)
+
+	// Nothing to change, so the item is only read
+	resp, err = UpdateChangedFields(c, item, item)
+	assert.Nil(t, err)
+	assert.Equal(t, "unchanged", resp.Location)
+	resp, err = UpdateFields(c, item, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "unchanged", resp.Location)
+}
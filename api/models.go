@@ -3,6 +3,7 @@ package api
 import (
 	"encoding/json"
 	"fmt"
+	"slices"
 )
 
 // Models should be named like ResourceName. This name is mapped to snake_case for the
@@ -407,6 +408,46 @@ func (VaultAccount) Endpoint() string {
 	return "vault/account"
 }
 
+// Windows Domain and Local accounts are discovered by the appliance rather than created
+// through the API, so these are only used by data sources
+type VaultDomainAccount struct {
+	ID                    *int    `json:"id,omitempty"`
+	Type                  string  `json:"type"`
+	Name                  string  `json:"name"`
+	Username              string  `json:"username"`
+	Description           string  `json:"description"`
+	LastCheckoutTimestamp *string `json:"last_checkout_timestamp,omitempty"`
+	AccountGroupID        int     `json:"account_group_id"`
+	AccountPolicy         *string `json:"account_policy"`
+	IsManagement          bool    `json:"is_management"`
+	UniqueID              string  `json:"unique_id"`
+	DistinguishedName     string  `json:"distinguished_name"`
+	SamAccountName        string  `json:"sam_account_name"`
+	ServicePrincipalName  string  `json:"service_principal_name"`
+	AzureAdObjectID       string  `json:"azure_ad_object_id"`
+}
+
+func (VaultDomainAccount) Endpoint() string {
+	return "vault/account"
+}
+
+type VaultLocalAccount struct {
+	ID                    *int    `json:"id,omitempty"`
+	Type                  string  `json:"type"`
+	Name                  string  `json:"name"`
+	Username              string  `json:"username"`
+	Description           string  `json:"description"`
+	LastCheckoutTimestamp *string `json:"last_checkout_timestamp,omitempty"`
+	AccountGroupID        int     `json:"account_group_id"`
+	AccountPolicy         *string `json:"account_policy"`
+	Sid                   string  `json:"sid"`
+	EndpointID            *int    `json:"endpoint_id,omitempty"`
+}
+
+func (VaultLocalAccount) Endpoint() string {
+	return "vault/account"
+}
+
 type VaultUsernamePasswordAccount struct {
 	ID             *int    `json:"id,omitempty"`
 	Type           string  `json:"type"`
@@ -543,6 +584,187 @@ type InjectableJumpItem struct {
 	Type string `json:"type" tfsdk:"type"`
 }
 
+// JumpItemAssociationEntry is a single Jump Item or shared Jump Group in the
+// Jump Item association criteria of a Vault Account or Account Group. Entries
+// are added and removed individually, but can only be read as part of the
+// whole association
+type JumpItemAssociationEntry interface {
+	APIResource
+	AssociationEndpoint() string
+	ParentID() int
+	EntryID() int
+}
+
+// JumpItemAssociationEntryPtr is a pointer to a JumpItemAssociationEntry, which
+// can have its IDs set and be looked up in the association it belongs to
+type JumpItemAssociationEntryPtr[T APIResource] interface {
+	*T
+	JumpItemAssociationEntry
+	SetEntry(parentID int, entryID int)
+	FindIn(association AccountJumpItemAssociation) bool
+}
+
+type VaultAccountJumpItemAssociation struct {
+	AccountID    *int   `tfsdk:"account_id" json:"-"`
+	JumpItemID   int    `tfsdk:"jump_item_id" json:"id"`
+	JumpItemType string `tfsdk:"jump_item_type" json:"type"`
+}
+
+func (a VaultAccountJumpItemAssociation) Endpoint() string {
+	return fmt.Sprintf("vault/account/%d/jump-item-association/jump-item", *a.AccountID)
+}
+
+func (a VaultAccountJumpItemAssociation) AssociationEndpoint() string {
+	return fmt.Sprintf("vault/account/%d/jump-item-association", *a.AccountID)
+}
+
+func (a VaultAccountJumpItemAssociation) ParentID() int {
+	return memberID(a.AccountID)
+}
+
+func (a VaultAccountJumpItemAssociation) EntryID() int {
+	return a.JumpItemID
+}
+
+func (a *VaultAccountJumpItemAssociation) SetEntry(parentID int, entryID int) {
+	a.AccountID = &parentID
+	a.JumpItemID = entryID
+}
+
+func (a *VaultAccountJumpItemAssociation) FindIn(association AccountJumpItemAssociation) bool {
+	return findJumpItem(association, a.JumpItemID, &a.JumpItemType)
+}
+
+type VaultAccountSharedJumpGroupAssociation struct {
+	AccountID         *int `tfsdk:"account_id" json:"-"`
+	SharedJumpGroupID int  `tfsdk:"shared_jump_group_id" json:"shared_jump_group_id"`
+}
+
+func (a VaultAccountSharedJumpGroupAssociation) Endpoint() string {
+	return fmt.Sprintf("vault/account/%d/jump-item-association/shared-jump-group", *a.AccountID)
+}
+
+func (a VaultAccountSharedJumpGroupAssociation) AssociationEndpoint() string {
+	return fmt.Sprintf("vault/account/%d/jump-item-association", *a.AccountID)
+}
+
+func (a VaultAccountSharedJumpGroupAssociation) ParentID() int {
+	return memberID(a.AccountID)
+}
+
+func (a VaultAccountSharedJumpGroupAssociation) EntryID() int {
+	return a.SharedJumpGroupID
+}
+
+func (a *VaultAccountSharedJumpGroupAssociation) SetEntry(parentID int, entryID int) {
+	a.AccountID = &parentID
+	a.SharedJumpGroupID = entryID
+}
+
+func (a *VaultAccountSharedJumpGroupAssociation) FindIn(association AccountJumpItemAssociation) bool {
+	return findSharedJumpGroup(association, a.SharedJumpGroupID)
+}
+
+type VaultAccountGroupJumpItemAssociation struct {
+	AccountGroupID *int   `tfsdk:"account_group_id" json:"-"`
+	JumpItemID     int    `tfsdk:"jump_item_id" json:"id"`
+	JumpItemType   string `tfsdk:"jump_item_type" json:"type"`
+}
+
+func (a VaultAccountGroupJumpItemAssociation) Endpoint() string {
+	return fmt.Sprintf("vault/account-group/%d/jump-item-association/jump-item", *a.AccountGroupID)
+}
+
+func (a VaultAccountGroupJumpItemAssociation) AssociationEndpoint() string {
+	return fmt.Sprintf("vault/account-group/%d/jump-item-association", *a.AccountGroupID)
+}
+
+func (a VaultAccountGroupJumpItemAssociation) ParentID() int {
+	return memberID(a.AccountGroupID)
+}
+
+func (a VaultAccountGroupJumpItemAssociation) EntryID() int {
+	return a.JumpItemID
+}
+
+func (a *VaultAccountGroupJumpItemAssociation) SetEntry(parentID int, entryID int) {
+	a.AccountGroupID = &parentID
+	a.JumpItemID = entryID
+}
+
+func (a *VaultAccountGroupJumpItemAssociation) FindIn(association AccountJumpItemAssociation) bool {
+	return findJumpItem(association, a.JumpItemID, &a.JumpItemType)
+}
+
+type VaultAccountGroupSharedJumpGroupAssociation struct {
+	AccountGroupID    *int `tfsdk:"account_group_id" json:"-"`
+	SharedJumpGroupID int  `tfsdk:"shared_jump_group_id" json:"shared_jump_group_id"`
+}
+
+func (a VaultAccountGroupSharedJumpGroupAssociation) Endpoint() string {
+	return fmt.Sprintf("vault/account-group/%d/jump-item-association/shared-jump-group", *a.AccountGroupID)
+}
+
+func (a VaultAccountGroupSharedJumpGroupAssociation) AssociationEndpoint() string {
+	return fmt.Sprintf("vault/account-group/%d/jump-item-association", *a.AccountGroupID)
+}
+
+func (a VaultAccountGroupSharedJumpGroupAssociation) ParentID() int {
+	return memberID(a.AccountGroupID)
+}
+
+func (a VaultAccountGroupSharedJumpGroupAssociation) EntryID() int {
+	return a.SharedJumpGroupID
+}
+
+func (a *VaultAccountGroupSharedJumpGroupAssociation) SetEntry(parentID int, entryID int) {
+	a.AccountGroupID = &parentID
+	a.SharedJumpGroupID = entryID
+}
+
+func (a *VaultAccountGroupSharedJumpGroupAssociation) FindIn(association AccountJumpItemAssociation) bool {
+	return findSharedJumpGroup(association, a.SharedJumpGroupID)
+}
+
+// Looks for the Jump Item in the association, filling in its type when it isn't known yet
+func findJumpItem(association AccountJumpItemAssociation, id int, jumpItemType *string) bool {
+	for _, item := range association.JumpItems {
+		if item.ID == id && (*jumpItemType == "" || item.Type == *jumpItemType) {
+			*jumpItemType = item.Type
+			return true
+		}
+	}
+	return false
+}
+
+func findSharedJumpGroup(association AccountJumpItemAssociation, id int) bool {
+	return association.Criteria != nil && slices.Contains(association.Criteria.SharedJumpGroups, id)
+}
+
+// GroupPolicyMembership is an object's membership in a group policy. The
+// membership endpoints all live under the group policy, and refer to the
+// member object by its ID
+type GroupPolicyMembership interface {
+	APIResource
+	GroupPolicy() string
+	MemberID() int
+}
+
+// GroupPolicyMembershipPtr is a pointer to a GroupPolicyMembership, which can
+// have its group policy and member IDs set
+type GroupPolicyMembershipPtr[T APIResource] interface {
+	*T
+	GroupPolicyMembership
+	SetMembership(groupPolicyID string, memberID int)
+}
+
+func memberID(id *int) int {
+	if id == nil {
+		return 0
+	}
+	return *id
+}
+
 type GroupPolicyVaultAccountGroup struct {
 	GroupPolicyID  *string `tfsdk:"group_policy_id" json:"-"`
 	AccountGroupID *int    `tfsdk:"-" json:"account_group_id"`
@@ -553,6 +775,19 @@ func (a GroupPolicyVaultAccountGroup) Endpoint() string {
 	return fmt.Sprintf("group-policy/%s/vault-account-group", *a.GroupPolicyID)
 }
 
+func (a GroupPolicyVaultAccountGroup) GroupPolicy() string {
+	return *a.GroupPolicyID
+}
+
+func (a GroupPolicyVaultAccountGroup) MemberID() int {
+	return memberID(a.AccountGroupID)
+}
+
+func (a *GroupPolicyVaultAccountGroup) SetMembership(groupPolicyID string, memberID int) {
+	a.GroupPolicyID = &groupPolicyID
+	a.AccountGroupID = &memberID
+}
+
 type GroupPolicyVaultAccount struct {
 	GroupPolicyID *string `tfsdk:"group_policy_id" json:"-"`
 	AccountID     *int    `tfsdk:"-" json:"account_id"`
@@ -563,6 +798,19 @@ func (a GroupPolicyVaultAccount) Endpoint() string {
 	return fmt.Sprintf("group-policy/%s/vault-account", *a.GroupPolicyID)
 }
 
+func (a GroupPolicyVaultAccount) GroupPolicy() string {
+	return *a.GroupPolicyID
+}
+
+func (a GroupPolicyVaultAccount) MemberID() int {
+	return memberID(a.AccountID)
+}
+
+func (a *GroupPolicyVaultAccount) SetMembership(groupPolicyID string, memberID int) {
+	a.GroupPolicyID = &groupPolicyID
+	a.AccountID = &memberID
+}
+
 type GroupPolicyProvision struct {
 	GroupPolicyID *string `tfsdk:"group_policy_id" json:"-"`
 }
@@ -582,6 +830,19 @@ func (a GroupPolicyJumpGroup) Endpoint() string {
 	return fmt.Sprintf("group-policy/%s/jump-group", *a.GroupPolicyID)
 }
 
+func (a GroupPolicyJumpGroup) GroupPolicy() string {
+	return *a.GroupPolicyID
+}
+
+func (a GroupPolicyJumpGroup) MemberID() int {
+	return memberID(a.JumpGroupID)
+}
+
+func (a *GroupPolicyJumpGroup) SetMembership(groupPolicyID string, memberID int) {
+	a.GroupPolicyID = &groupPolicyID
+	a.JumpGroupID = &memberID
+}
+
 type GroupPolicyJumpoint struct {
 	GroupPolicyID *string `tfsdk:"group_policy_id" json:"-"`
 	JumpointID    *int    `tfsdk:"-" json:"jumpoint_id"`
@@ -591,6 +852,119 @@ func (a GroupPolicyJumpoint) Endpoint() string {
 	return fmt.Sprintf("group-policy/%s/jumpoint", *a.GroupPolicyID)
 }
 
+func (a GroupPolicyJumpoint) GroupPolicy() string {
+	return *a.GroupPolicyID
+}
+
+func (a GroupPolicyJumpoint) MemberID() int {
+	return memberID(a.JumpointID)
+}
+
+func (a *GroupPolicyJumpoint) SetMembership(groupPolicyID string, memberID int) {
+	a.GroupPolicyID = &groupPolicyID
+	a.JumpointID = &memberID
+}
+
+type JumpGroupUser struct {
+	JumpGroupID    *int `json:"-"`
+	UserID         *int `json:"user_id"`
+	JumpItemRoleID *int `json:"jump_item_role_id,omitempty"`
+	JumpPolicyID   *int `json:"jump_policy_id,omitempty" sraproduct:"pra"`
+}
+
+func (a JumpGroupUser) Endpoint() string {
+	return fmt.Sprintf("jump-group/%d/user", *a.JumpGroupID)
+}
+
+type JumpointUser struct {
+	JumpointID *int `json:"-"`
+	UserID     *int `json:"user_id"`
+}
+
+func (a JumpointUser) Endpoint() string {
+	return fmt.Sprintf("jumpoint/%d/user", *a.JumpointID)
+}
+
+type VaultEndpoint struct {
+	ID                *int   `json:"id,omitempty"`
+	Name              string `json:"name"`
+	OperatingSystem   string `json:"operating_system"`
+	DomainName        string `json:"domain_name"`
+	DistinguishedName string `json:"distinguished_name"`
+	Hostname          string `json:"hostname"`
+	Description       string `json:"description"`
+}
+
+func (VaultEndpoint) Endpoint() string {
+	return "vault/endpoint"
+}
+
+type VaultEndpointRemoteRDPAssociation struct {
+	EndpointID *int `json:"-"`
+	ID         *int `json:"id"`
+}
+
+func (a VaultEndpointRemoteRDPAssociation) Endpoint() string {
+	return fmt.Sprintf("vault/endpoint/%d/remote-rdp-jump-item-association", *a.EndpointID)
+}
+
+type VaultEndpointRemoteRDPCandidate struct {
+	EndpointID *int `json:"-"`
+	ID         *int `json:"id"`
+}
+
+func (a VaultEndpointRemoteRDPCandidate) Endpoint() string {
+	return fmt.Sprintf("vault/endpoint/%d/remote-rdp-jump-item-candidates", *a.EndpointID)
+}
+
+// VaultUserMembershipPtr is a pointer to a direct user membership of a Vault
+// Account or Account Group. Memberships are read, updated and removed at
+// <Endpoint()>/<user_id>
+type VaultUserMembershipPtr[T APIResource] interface {
+	*T
+	APIResource
+	MembershipIDs() (parentID int, userID int)
+	SetMembershipIDs(parentID int, userID int)
+}
+
+type VaultAccountUser struct {
+	AccountID *int   `json:"-"`
+	UserID    *int   `json:"user_id"`
+	Role      string `json:"role"`
+}
+
+func (a VaultAccountUser) Endpoint() string {
+	return fmt.Sprintf("vault/account/%d/user", *a.AccountID)
+}
+
+func (a VaultAccountUser) MembershipIDs() (int, int) {
+	return *a.AccountID, *a.UserID
+}
+
+func (a *VaultAccountUser) SetMembershipIDs(accountID int, userID int) {
+	a.AccountID = &accountID
+	a.UserID = &userID
+}
+
+type VaultAccountGroupUser struct {
+	AccountGroupID *int   `json:"-"`
+	UserID         *int   `json:"user_id"`
+	Role           string `json:"role"`
+}
+
+func (a VaultAccountGroupUser) Endpoint() string {
+	return fmt.Sprintf("vault/account-group/%d/user", *a.AccountGroupID)
+}
+
+func (a VaultAccountGroupUser) MembershipIDs() (int, int) {
+	return *a.AccountGroupID, *a.UserID
+}
+
+func (a *VaultAccountGroupUser) SetMembershipIDs(accountGroupID int, userID int) {
+	a.AccountGroupID = &accountGroupID
+	a.UserID = &userID
+}
+
 type MechList struct {
 	Mechs       []string   `json:"mechs"`
 	DefaultMech string     `json:"default_mech"`
@@ -625,3 +999,97 @@ type VaultSecret struct {
 func (a VaultSecret) Endpoint() string {
 	return fmt.Sprintf("vault/account/%d", *a.ID)
 }
+
+// Returns the secret from whichever field the account type stores it in
+func (a VaultSecret) SecretValue() string {
+	var secret *string
+	switch a.Type {
+	case "ssh", "ssh_ca":
+		secret = a.PrivateKey
+	case "opaque_token":
+		secret = a.Token
+	default:
+		secret = a.Password
+	}
+
+	if secret == nil {
+		return ""
+	}
+	return *secret
+}
+
+type EndpointAutomationJob struct {
+	ID                    *int                         `json:"id,omitempty"`
+	Name                  string                       `json:"name"`
+	Notes                 string                       `json:"notes"`
+	StartAt               string                       `json:"start_at"`
+	Tag                   string                       `json:"tag"`
+	OperatingSystem       string                       `json:"operating_system"`
+	EndpointIDs           []int                        `json:"endpoint_ids,omitempty"`
+	Payload               EndpointAutomationJobPayload `json:"payload"`
+	CreatedByAPIAccountID *int                         `json:"created_by_api_account_id,omitempty"`
+	CreatedByUserID       *int                         `json:"created_by_user_id,omitempty"`
+	CreatedAt             *string                      `json:"created_at,omitempty"`
+	FinishedTimestamp     *string                      `json:"finished_timestamp,omitempty"`
+}
+
+func (EndpointAutomationJob) Endpoint() string {
+	return "endpoint-automation/job"
+}
+
+// The request body takes resource_ids, while the response describes the
+// resources that were attached to the job. The spec documents resources as a
+// single object even though a job can have several, so both shapes are accepted.
+type EndpointAutomationJobPayload struct {
+	Cmd         string           `json:"cmd"`
+	Script      string           `json:"script"`
+	ResourceIDs []int            `json:"resource_ids,omitempty"`
+	Resources   *json.RawMessage `json:"resources,omitempty"`
+}
+
+func (p EndpointAutomationJobPayload) AttachedResourceIDs() []int {
+	if p.Resources == nil {
+		return p.ResourceIDs
+	}
+
+	var list []EndpointAutomationMinimalResource
+	if err := json.Unmarshal(*p.Resources, &list); err != nil {
+		var single EndpointAutomationMinimalResource
+		if err := json.Unmarshal(*p.Resources, &single); err != nil || single.ID == nil {
+			return p.ResourceIDs
+		}
+		list = []EndpointAutomationMinimalResource{single}
+	}
+
+	ids := []int{}
+	for _, r := range list {
+		if r.ID != nil {
+			ids = append(ids, *r.ID)
+		}
+	}
+	return ids
+}
+
+type EndpointAutomationMinimalResource struct {
+	ID         *int   `json:"id,omitempty"`
+	FileName   string `json:"file_name"`
+	FileSize   int    `json:"file_size"`
+	DisplayURL string `json:"display_url"`
+}
+
+type EndpointAutomationJobExecution struct {
+	JobID                   *int     `json:"-"`
+	State                   string   `json:"state"`
+	StartedRunningTimestamp *string  `json:"started_running_timestamp,omitempty"`
+	FinishedTimestamp       *string  `json:"finished_timestamp,omitempty"`
+	FinishedType            *string  `json:"finished_type,omitempty"`
+	FinishedError           *string  `json:"finished_error,omitempty"`
+	LogFilePath             *string  `json:"log_file_path,omitempty"`
+	EndpointID              *int     `json:"endpoint_id,omitempty"`
+	OutputTail              *string  `json:"output_tail,omitempty"`
+	ExitCode                *float64 `json:"exit_code,omitempty"`
+}
+
+func (a EndpointAutomationJobExecution) Endpoint() string {
+	return fmt.Sprintf("endpoint-automation/job/%d/execution", *a.JobID)
+}
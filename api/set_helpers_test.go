@@ -6,6 +6,14 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
+func groupPolicies[T APIResource, PT GroupPolicyMembershipPtr[T]](list []T) []string {
+	ids := []string{}
+	for _, m := range list {
+		ids = append(ids, PT(&m).GroupPolicy())
+	}
+	return ids
+}
+
 func TestDiffGPAccountLists(t *testing.T) {
 	t.Parallel()
 
@@ -33,26 +41,27 @@ func TestDiffGPAccountLists(t *testing.T) {
 		Role:          "role3",
 	}
 
-	set1 := []GroupPolicyVaultAccount{toAddItem, noChangeItem}
-	set2 := []GroupPolicyVaultAccount{toRemoveItem, noChangeItem}
-
-	toAdd, toRemove, noChange := DiffGPAccountLists(set1, set2)
+	gpID4 := "4"
+	toUpdateItem := GroupPolicyVaultAccount{
+		GroupPolicyID: &gpID4,
+		Role:          "role4",
+	}
+	currentItem := toUpdateItem
+	currentItem.Role = "role1"
 
-	assert.Len(t, toAdd.ToSlice(), 1)
-	assert.Len(t, toRemove.ToSlice(), 1)
-	assert.Len(t, noChange.ToSlice(), 1)
+	// The member ID isn't in the Terraform state, so it's ignored
+	noChangeState := noChangeItem
+	noChangeState.AccountID = nil
 
-	assert.Equal(t, *toAddItem.GroupPolicyID, *toAdd.ToSlice()[0].GroupPolicyID)
-	assert.Nil(t, toAdd.ToSlice()[0].AccountID)
-	assert.Equal(t, toAddItem.Role, toAdd.ToSlice()[0].Role)
+	set1 := []GroupPolicyVaultAccount{toAddItem, noChangeItem, toUpdateItem}
+	set2 := []GroupPolicyVaultAccount{toRemoveItem, noChangeState, currentItem}
 
-	assert.Equal(t, *toRemoveItem.GroupPolicyID, *toRemove.ToSlice()[0].GroupPolicyID)
-	assert.Nil(t, toRemove.ToSlice()[0].AccountID)
-	assert.Equal(t, toRemoveItem.Role, toRemove.ToSlice()[0].Role)
+	toAdd, toUpdate, toRemove, noChange := DiffGroupPolicyMemberships(set1, set2)
 
-	assert.Equal(t, *noChangeItem.GroupPolicyID, *noChange.ToSlice()[0].GroupPolicyID)
-	assert.Nil(t, noChange.ToSlice()[0].AccountID)
-	assert.Equal(t, noChangeItem.Role, noChange.ToSlice()[0].Role)
+	assert.Equal(t, []GroupPolicyVaultAccount{toAddItem}, toAdd)
+	assert.Equal(t, []GroupPolicyVaultAccount{toUpdateItem}, toUpdate)
+	assert.Equal(t, []GroupPolicyVaultAccount{toRemoveItem}, toRemove)
+	assert.Equal(t, []GroupPolicyVaultAccount{noChangeItem}, noChange)
 }
 
 func TestDiffGPAccountGroupLists(t *testing.T) {
@@ -82,26 +91,23 @@ func TestDiffGPAccountGroupLists(t *testing.T) {
 		Role:           "role3",
 	}
 
-	set1 := []GroupPolicyVaultAccountGroup{toAddItem, noChangeItem}
-	set2 := []GroupPolicyVaultAccountGroup{toRemoveItem, noChangeItem}
-
-	toAdd, toRemove, noChange := DiffGPAccountGroupLists(set1, set2)
-
-	assert.Len(t, toAdd.ToSlice(), 1)
-	assert.Len(t, toRemove.ToSlice(), 1)
-	assert.Len(t, noChange.ToSlice(), 1)
+	gpID4 := "4"
+	toUpdateItem := GroupPolicyVaultAccountGroup{
+		GroupPolicyID: &gpID4,
+		Role:          "role4",
+	}
+	currentItem := toUpdateItem
+	currentItem.Role = "role1"
 
-	assert.Equal(t, *toAddItem.GroupPolicyID, *toAdd.ToSlice()[0].GroupPolicyID)
-	assert.Nil(t, toAdd.ToSlice()[0].AccountGroupID)
-	assert.Equal(t, toAddItem.Role, toAdd.ToSlice()[0].Role)
+	set1 := []GroupPolicyVaultAccountGroup{toAddItem, noChangeItem, toUpdateItem}
+	set2 := []GroupPolicyVaultAccountGroup{toRemoveItem, noChangeItem, currentItem}
 
-	assert.Equal(t, *toRemoveItem.GroupPolicyID, *toRemove.ToSlice()[0].GroupPolicyID)
-	assert.Nil(t, toRemove.ToSlice()[0].AccountGroupID)
-	assert.Equal(t, toRemoveItem.Role, toRemove.ToSlice()[0].Role)
+	toAdd, toUpdate, toRemove, noChange := DiffGroupPolicyMemberships(set1, set2)
 
-	assert.Equal(t, *noChangeItem.GroupPolicyID, *noChange.ToSlice()[0].GroupPolicyID)
-	assert.Nil(t, noChange.ToSlice()[0].AccountGroupID)
-	assert.Equal(t, noChangeItem.Role, noChange.ToSlice()[0].Role)
+	assert.Equal(t, []GroupPolicyVaultAccountGroup{toAddItem}, toAdd)
+	assert.Equal(t, []GroupPolicyVaultAccountGroup{toUpdateItem}, toUpdate)
+	assert.Equal(t, []GroupPolicyVaultAccountGroup{toRemoveItem}, toRemove)
+	assert.Equal(t, []GroupPolicyVaultAccountGroup{noChangeItem}, noChange)
 }
 
 func TestDiffGPJumpItemLists(t *testing.T) {
@@ -137,29 +143,40 @@ func TestDiffGPJumpItemLists(t *testing.T) {
 		JumpPolicyID:   &policyID3,
 	}
 
-	set1 := []GroupPolicyJumpGroup{toAddItem, noChangeItem}
-	set2 := []GroupPolicyJumpGroup{toRemoveItem, noChangeItem}
+	// Changing only the role or the policy updates the membership
+	gpID4 := "4"
+	roleChangeItem := GroupPolicyJumpGroup{
+		GroupPolicyID:  &gpID4,
+		JumpItemRoleID: 4,
+		JumpPolicyID:   &policyID1,
+	}
+	roleCurrentItem := roleChangeItem
+	roleCurrentItem.JumpItemRoleID = 1
 
-	toAdd, toRemove, noChange := DiffGPJumpItemLists(set1, set2)
+	gpID5 := "5"
+	policyChangeItem := GroupPolicyJumpGroup{
+		GroupPolicyID:  &gpID5,
+		JumpItemRoleID: 1,
+		JumpPolicyID:   &policyID2,
+	}
+	policyCurrentItem := policyChangeItem
+	policyCurrentItem.JumpPolicyID = &policyID1
 
-	assert.Len(t, toAdd.ToSlice(), 1)
-	assert.Len(t, toRemove.ToSlice(), 1)
-	assert.Len(t, noChange.ToSlice(), 1)
+	set1 := []GroupPolicyJumpGroup{toAddItem, noChangeItem, roleChangeItem, policyChangeItem}
+	set2 := []GroupPolicyJumpGroup{toRemoveItem, noChangeItem, roleCurrentItem, policyCurrentItem}
 
-	assert.Equal(t, *toAddItem.GroupPolicyID, *toAdd.ToSlice()[0].GroupPolicyID)
-	assert.Nil(t, toAdd.ToSlice()[0].JumpGroupID)
-	assert.Equal(t, toAddItem.JumpItemRoleID, toAdd.ToSlice()[0].JumpItemRoleID)
-	assert.Equal(t, *toAddItem.JumpPolicyID, *toAdd.ToSlice()[0].JumpPolicyID)
+	toAdd, toUpdate, toRemove, noChange := DiffGroupPolicyMemberships(set1, set2)
 
-	assert.Equal(t, *toRemoveItem.GroupPolicyID, *toRemove.ToSlice()[0].GroupPolicyID)
-	assert.Nil(t, toRemove.ToSlice()[0].JumpGroupID)
-	assert.Equal(t, toRemoveItem.JumpItemRoleID, toRemove.ToSlice()[0].JumpItemRoleID)
-	assert.Equal(t, *toRemoveItem.JumpPolicyID, *toRemove.ToSlice()[0].JumpPolicyID)
+	assert.Equal(t, []GroupPolicyJumpGroup{toAddItem}, toAdd)
+	assert.Equal(t, []GroupPolicyJumpGroup{roleChangeItem, policyChangeItem}, toUpdate)
+	assert.Equal(t, []GroupPolicyJumpGroup{toRemoveItem}, toRemove)
+	assert.Equal(t, []GroupPolicyJumpGroup{noChangeItem}, noChange)
 
-	assert.Equal(t, *noChangeItem.GroupPolicyID, *noChange.ToSlice()[0].GroupPolicyID)
-	assert.Nil(t, noChange.ToSlice()[0].JumpGroupID)
-	assert.Equal(t, noChangeItem.JumpItemRoleID, noChange.ToSlice()[0].JumpItemRoleID)
-	assert.Equal(t, *noChangeItem.JumpPolicyID, *noChange.ToSlice()[0].JumpPolicyID)
+	// RS has no Jump Policies
+	rsItem := GroupPolicyJumpGroup{GroupPolicyID: &gpID1, JumpItemRoleID: 1}
+	_, toUpdate, _, noChange = DiffGroupPolicyMemberships([]GroupPolicyJumpGroup{rsItem}, []GroupPolicyJumpGroup{rsItem})
+	assert.Empty(t, toUpdate)
+	assert.Equal(t, []string{"1"}, groupPolicies(noChange))
 }
 
 func TestDiffGPJumpointLists(t *testing.T) {
@@ -186,16 +203,13 @@ func TestDiffGPJumpointLists(t *testing.T) {
 		JumpointID:    &jumpointID3,
 	}
 
-	set1 := []GroupPolicyJumpoint{toAddItem, noChangeItem}
+	set1 := []GroupPolicyJumpoint{toAddItem, noChangeItem, noChangeItem}
 	set2 := []GroupPolicyJumpoint{toRemoveItem, noChangeItem}
 
-	toAdd, toRemove, noChange := DiffGPJumpointLists(set1, set2)
-
-	assert.Len(t, toAdd.ToSlice(), 1)
-	assert.Len(t, toRemove.ToSlice(), 1)
-	assert.Len(t, noChange.ToSlice(), 1)
+	toAdd, toUpdate, toRemove, noChange := DiffGroupPolicyMemberships(set1, set2)
 
-	assert.Equal(t, *toAddItem.GroupPolicyID, *toAdd.ToSlice()[0].GroupPolicyID)
-	assert.Equal(t, *toRemoveItem.GroupPolicyID, *toRemove.ToSlice()[0].GroupPolicyID)
-	assert.Equal(t, *noChangeItem.GroupPolicyID, *noChange.ToSlice()[0].GroupPolicyID)
+	assert.Equal(t, []string{"1"}, groupPolicies(toAdd))
+	assert.Empty(t, toUpdate)
+	assert.Equal(t, []string{"2"}, groupPolicies(toRemove))
+	assert.Equal(t, []string{"3"}, groupPolicies(noChange))
 }
@@ -13,3 +13,17 @@ func ToSnakeCase(str string) string {
 	snake = matchAllCap.ReplaceAllString(snake, "${1}_${2}")
 	return strings.ToLower(snake)
 }
+
+// Returns the DNS domain from the DC components of an LDAP distinguished name,
+// so "CN=svc,OU=Service,DC=example,DC=local" becomes "example.local"
+func DomainFromDistinguishedName(dn string) string {
+	parts := []string{}
+	for _, rdn := range strings.Split(dn, ",") {
+		kv := strings.SplitN(strings.TrimSpace(rdn), "=", 2)
+		if len(kv) == 2 && strings.EqualFold(kv[0], "DC") && kv[1] != "" {
+			parts = append(parts, kv[1])
+		}
+	}
+
+	return strings.Join(parts, ".")
+}
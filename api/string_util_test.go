@@ -16,3 +16,12 @@ func TestTransformToSnakeCase(t *testing.T) {
 	assert.Equal(t, "this_one_has_caps", testString)
 
 }
+
+func TestDomainFromDistinguishedName(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "example.local", DomainFromDistinguishedName("CN=svc_sql,OU=Service Accounts,DC=example,DC=local"))
+	assert.Equal(t, "corp.example.com", DomainFromDistinguishedName("cn=admin, dc=corp, dc=example, dc=com"))
+	assert.Equal(t, "", DomainFromDistinguishedName("CN=nobody"))
+	assert.Equal(t, "", DomainFromDistinguishedName(""))
+}
@@ -0,0 +1,15 @@
+package act
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/action"
+)
+
+// Factory function to return the list of all action–generating factories to the main provider
+// Add new action factory functions here.
+func ActionList() []func() action.Action {
+	return []func() action.Action{
+		newVaultAccountRotateAction,
+		newVaultAccountForceCheckInAction,
+		newVaultAccountCheckInAction,
+	}
+}
@@ -0,0 +1,129 @@
+package act
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"terraform-provider-sra/api"
+	"terraform-provider-sra/bt/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// These throw away variable declarations are to allow the compiler to
+// enforce compliance to these interfaces
+var (
+	_ action.Action              = &vaultAccountAction{}
+	_ action.ActionWithConfigure = &vaultAccountAction{}
+)
+
+func newVaultAccountRotateAction() action.Action {
+	return &vaultAccountAction{
+		name:      "vault_account_rotate",
+		operation: "rotate",
+		verb:      "rotating",
+		description: `Rotates the credentials of a Vault Account.
+
+This is useful as a lifecycle action to rotate the credentials of an account right after the host that uses it has been provisioned. The rotation is scheduled by the appliance and may not be complete when the action finishes.`,
+	}
+}
+
+func newVaultAccountForceCheckInAction() action.Action {
+	return &vaultAccountAction{
+		name:      "vault_account_force_check_in",
+		operation: "force-check-in",
+		verb:      "force checking in",
+		description: `Forces a Vault Account to be checked in, regardless of who has it checked out.
+
+Use this to clear a stuck check out with ` + "`terraform apply -invoke`" + `.`,
+	}
+}
+
+func newVaultAccountCheckInAction() action.Action {
+	return &vaultAccountAction{
+		name:      "vault_account_check_in",
+		operation: "check-in",
+		verb:      "checking in",
+		description: `Checks in a Vault Account that is checked out by the API account being used.
+
+Use ` + "`sra_vault_account_force_check_in`" + ` to check in an account that was checked out by someone else.`,
+	}
+}
+
+// All of the Vault Account actions are a POST to vault/account/<id>/<operation>
+// with no body, so they only differ by the operation and how they are described
+type vaultAccountAction struct {
+	apiClient   *api.APIClient
+	name        string
+	operation   string
+	verb        string
+	description string
+}
+
+func (a *vaultAccountAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_%s", req.ProviderTypeName, a.name)
+	tflog.Debug(ctx, fmt.Sprintf("🥃 Registered action name [%s]", resp.TypeName))
+}
+
+func (a *vaultAccountAction) Configure(_ context.Context, req action.ConfigureRequest, _ *action.ConfigureResponse) {
+	if req.ProviderData == nil || a == nil {
+		return
+	}
+
+	a.apiClient = req.ProviderData.(*api.APIClient)
+}
+
+func (a *vaultAccountAction) Schema(ctx context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: a.description + `
+
+NOTE: The API account being used must have permission to manage the account with the provided ID.
+
+For descriptions of individual fields, please see the Configuration API documentation on your SRA Appliance`,
+		Attributes: map[string]schema.Attribute{
+			"account_id": schema.StringAttribute{
+				Description: "The ID of the Vault Account",
+				Required:    true,
+			},
+		},
+	}
+}
+
+func (a *vaultAccountAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data models.VaultAccountAction
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.Atoi(data.AccountID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid account ID",
+			"The account ID ["+data.AccountID.ValueString()+"] must be a number",
+		)
+		return
+	}
+
+	tflog.Info(ctx, "🥮 "+a.verb+" account", map[string]interface{}{
+		"id": id,
+	})
+	item := api.VaultSecret{ID: &id}
+	_, err = api.Post(a.apiClient, a.operation, item, true)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error "+a.verb+" account",
+			fmt.Sprintf("Unexpected error %s the account with ID [%d]: %s", a.verb, id, err.Error()),
+		)
+		return
+	}
+
+	if resp.SendProgress != nil {
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Finished %s Vault Account [%d]", a.verb, id),
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package act
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"terraform-provider-sra/api"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVaultAccountActionInvoke(t *testing.T) {
+	var called []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "oauth2/token") {
+			w.Header().Set("Content-Type", "application/json")
+			_, err := w.Write([]byte(`{"token_type":"Bearer","expires_in":3600,"access_token":"secret_access_granted"}`))
+			assert.Nil(t, err)
+		} else if r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "vault/account/42/check-in") {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			_, err := w.Write([]byte("not checked out"))
+			assert.Nil(t, err)
+		} else if r.Method == http.MethodPost {
+			called = append(called, r.URL.Path)
+			w.WriteHeader(http.StatusNoContent)
+		} else {
+			assert.Fail(t, "Bad request", r.URL)
+		}
+	}))
+	defer ts.Close()
+
+	clientID := "id"
+	clientSecret := "🤐"
+	c, err := api.NewClient(ts.URL, &clientID, &clientSecret)
+	c.SetTest(t)
+	assert.Nil(t, err)
+
+	invoke := func(a action.Action, accountID string) action.InvokeResponse {
+		a.(action.ActionWithConfigure).Configure(context.Background(), action.ConfigureRequest{ProviderData: c}, &action.ConfigureResponse{})
+
+		var schemaResp action.SchemaResponse
+		a.Schema(context.Background(), action.SchemaRequest{}, &schemaResp)
+
+		config := tfsdk.Config{
+			Schema: schemaResp.Schema,
+			Raw: tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+				"account_id": tftypes.String,
+			}}, map[string]tftypes.Value{
+				"account_id": tftypes.NewValue(tftypes.String, accountID),
+			}),
+		}
+
+		var resp action.InvokeResponse
+		a.Invoke(context.Background(), action.InvokeRequest{Config: config}, &resp)
+		return resp
+	}
+
+	resp := invoke(newVaultAccountRotateAction(), "12")
+	assert.False(t, resp.Diagnostics.HasError())
+
+	resp = invoke(newVaultAccountForceCheckInAction(), "12")
+	assert.False(t, resp.Diagnostics.HasError())
+
+	resp = invoke(newVaultAccountCheckInAction(), "12")
+	assert.False(t, resp.Diagnostics.HasError())
+
+	assert.Len(t, called, 3)
+	assert.True(t, strings.HasSuffix(called[0], "vault/account/12/rotate"))
+	assert.True(t, strings.HasSuffix(called[1], "vault/account/12/force-check-in"))
+	assert.True(t, strings.HasSuffix(called[2], "vault/account/12/check-in"))
+
+	resp = invoke(newVaultAccountCheckInAction(), "42")
+	assert.True(t, resp.Diagnostics.HasError())
+
+	resp = invoke(newVaultAccountRotateAction(), "not a number")
+	assert.True(t, resp.Diagnostics.HasError())
+	assert.Len(t, called, 3)
+}
@@ -20,9 +20,11 @@ func DatasourceList() []func() datasource.DataSource {
 		newGroupPolicyDataSource,
 		newJumpClientInstallerDataSource,
 		newJumpGroupDataSource,
+		newJumpGroupUserDataSource,
 		newJumpItemRoleDataSource,
 		newJumpPolicyDataSource,
 		newJumpointDataSource,
+		newJumpointUserDataSource,
 		newProtocolTunnelJumpDataSource,
 		newRemoteRDPDataSource,
 		newRemoteVNCDataSource,
@@ -37,6 +39,10 @@ func DatasourceList() []func() datasource.DataSource {
 		newVaultSSHAccountDataSource,
 		newVaultAccountGroupDataSource,
 		newVaultAccountPolicyDataSource,
+		newVaultDomainAccountDataSource,
+		newVaultLocalAccountDataSource,
+		newVaultEndpointDataSource,
+		newVaultEndpointRemoteRDPCandidateDataSource,
 		newVaultSecretDataSource,
 	}
 }
@@ -100,7 +106,14 @@ func (d *apiDataSource[TDataSource, TApi, TTf]) Read(ctx context.Context, req da
 // }
 
 func (d *apiDataSource[TDataSource, TApi, TTf]) doFilteredRead(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse, requestFilter map[string]string) []TTf {
-	items, err := api.ListItems[TApi](d.apiClient, requestFilter)
+	var tmp TApi
+	return d.doFilteredReadEndpoint(ctx, resp, tmp.Endpoint(), requestFilter)
+}
+
+// Same as doFilteredRead, but lists the items from the given endpoint. This is needed for
+// items that are nested under another object, where the endpoint depends on the parent's ID
+func (d *apiDataSource[TDataSource, TApi, TTf]) doFilteredReadEndpoint(ctx context.Context, resp *datasource.ReadResponse, endpoint string, requestFilter map[string]string) []TTf {
+	items, err := api.ListItemsEndpoint[TApi](d.apiClient, endpoint, requestFilter)
 	rb, _ := json.Marshal(items)
 	tflog.Debug(ctx, "🙀 ListItems got data", map[string]interface{}{
 		"data": string(rb),
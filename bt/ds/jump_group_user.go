@@ -0,0 +1,82 @@
+package ds
+
+import (
+	"context"
+	"terraform-provider-sra/api"
+	"terraform-provider-sra/bt/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &jumpGroupUserDataSource{}
+	_ datasource.DataSourceWithConfigure = &jumpGroupUserDataSource{}
+	_                                    = &jumpGroupUserDataSourceModel{}
+)
+
+func newJumpGroupUserDataSource() datasource.DataSource {
+	return &jumpGroupUserDataSource{}
+}
+
+type jumpGroupUserDataSource struct {
+	apiDataSource[jumpGroupUserDataSourceModel, api.JumpGroupUser, models.JumpGroupUserDS]
+}
+
+type jumpGroupUserDataSourceModel struct {
+	Items       []models.JumpGroupUserDS `tfsdk:"items"`
+	JumpGroupID types.Int64              `tfsdk:"jump_group_id"`
+}
+
+func (d *jumpGroupUserDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetch a list of the users explicitly granted access to a Jump Group. This does not include users that have access through a group policy.\n\nFor descriptions of individual fields, please see the Configuration API documentation on your SRA Appliance",
+		Attributes: map[string]schema.Attribute{
+			"items": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"user_id": schema.Int64Attribute{
+							Computed: true,
+						},
+						"jump_item_role_id": schema.Int64Attribute{
+							Computed: true,
+						},
+						"jump_policy_id": schema.Int64Attribute{
+							Description: "This field only applies to PRA",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"jump_group_id": schema.Int64Attribute{
+				Description: "The ID of the Jump Group to list the users of",
+				Required:    true,
+			},
+		},
+	}
+}
+
+func (d *jumpGroupUserDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state jumpGroupUserDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	jumpGroupID := int(state.JumpGroupID.ValueInt64())
+	tmp := api.JumpGroupUser{JumpGroupID: &jumpGroupID}
+	items := d.doFilteredReadEndpoint(ctx, resp, tmp.Endpoint(), nil)
+	if items == nil {
+		return
+	}
+	state.Items = items
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,75 @@
+package ds
+
+import (
+	"context"
+	"terraform-provider-sra/api"
+	"terraform-provider-sra/bt/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &jumpointUserDataSource{}
+	_ datasource.DataSourceWithConfigure = &jumpointUserDataSource{}
+	_                                    = &jumpointUserDataSourceModel{}
+)
+
+func newJumpointUserDataSource() datasource.DataSource {
+	return &jumpointUserDataSource{}
+}
+
+type jumpointUserDataSource struct {
+	apiDataSource[jumpointUserDataSourceModel, api.JumpointUser, models.JumpointUserDS]
+}
+
+type jumpointUserDataSourceModel struct {
+	Items      []models.JumpointUserDS `tfsdk:"items"`
+	JumpointID types.Int64             `tfsdk:"jumpoint_id"`
+}
+
+func (d *jumpointUserDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetch a list of the users explicitly granted access to a Jumpoint. This does not include users that have access through a group policy.\n\nFor descriptions of individual fields, please see the Configuration API documentation on your SRA Appliance",
+		Attributes: map[string]schema.Attribute{
+			"items": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"user_id": schema.Int64Attribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+			"jumpoint_id": schema.Int64Attribute{
+				Description: "The ID of the Jumpoint to list the users of",
+				Required:    true,
+			},
+		},
+	}
+}
+
+func (d *jumpointUserDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state jumpointUserDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	jumpointID := int(state.JumpointID.ValueInt64())
+	tmp := api.JumpointUser{JumpointID: &jumpointID}
+	items := d.doFilteredReadEndpoint(ctx, resp, tmp.Endpoint(), nil)
+	if items == nil {
+		return
+	}
+	state.Items = items
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -19,17 +19,17 @@ var (
 func newMySQLTunnelJumpDataSource() datasource.DataSource { return &mysqlTunnelJumpDataSource{} }
 
 type mysqlTunnelJumpDataSource struct {
-	apiDataSource[mysqlTunnelJumpDataSourceModel, api.MySQLTunnelJump, models.MySQLTunnelJump]
+	apiDataSource[mysqlTunnelJumpDataSourceModel, api.MySQLTunnelJump, models.MySQLTunnelJumpDS]
 }
 
 type mysqlTunnelJumpDataSourceModel struct {
-	Items         []models.MySQLTunnelJump `tfsdk:"items"`
-	Name          types.String             `tfsdk:"name" filter:"name"`
-	JumpointID    types.Int64              `tfsdk:"jumpoint_id" filter:"jumpoint_id"`
-	Hostname      types.String             `tfsdk:"hostname" filter:"hostname"`
-	JumpGroupID   types.Int64              `tfsdk:"jump_group_id" filter:"jump_group_id"`
-	JumpGroupType types.String             `tfsdk:"jump_group_type" filter:"jump_group_type"`
-	Tag           types.String             `tfsdk:"tag" filter:"tag"`
+	Items         []models.MySQLTunnelJumpDS `tfsdk:"items"`
+	Name          types.String               `tfsdk:"name" filter:"name"`
+	JumpointID    types.Int64                `tfsdk:"jumpoint_id" filter:"jumpoint_id"`
+	Hostname      types.String               `tfsdk:"hostname" filter:"hostname"`
+	JumpGroupID   types.Int64                `tfsdk:"jump_group_id" filter:"jump_group_id"`
+	JumpGroupType types.String               `tfsdk:"jump_group_type" filter:"jump_group_type"`
+	Tag           types.String               `tfsdk:"tag" filter:"tag"`
 }
 
 func (d *mysqlTunnelJumpDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
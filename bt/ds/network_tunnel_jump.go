@@ -19,16 +19,16 @@ var (
 func newNetworkTunnelJumpDataSource() datasource.DataSource { return &networkTunnelJumpDataSource{} }
 
 type networkTunnelJumpDataSource struct {
-	apiDataSource[networkTunnelJumpDataSourceModel, api.NetworkTunnelJump, models.NetworkTunnelJump]
+	apiDataSource[networkTunnelJumpDataSourceModel, api.NetworkTunnelJump, models.NetworkTunnelJumpDS]
 }
 
 type networkTunnelJumpDataSourceModel struct {
-	Items         []models.NetworkTunnelJump `tfsdk:"items"`
-	Name          types.String               `tfsdk:"name" filter:"name"`
-	JumpointID    types.Int64                `tfsdk:"jumpoint_id" filter:"jumpoint_id"`
-	JumpGroupID   types.Int64                `tfsdk:"jump_group_id" filter:"jump_group_id"`
-	JumpGroupType types.String               `tfsdk:"jump_group_type" filter:"jump_group_type"`
-	Tag           types.String               `tfsdk:"tag" filter:"tag"`
+	Items         []models.NetworkTunnelJumpDS `tfsdk:"items"`
+	Name          types.String                 `tfsdk:"name" filter:"name"`
+	JumpointID    types.Int64                  `tfsdk:"jumpoint_id" filter:"jumpoint_id"`
+	JumpGroupID   types.Int64                  `tfsdk:"jump_group_id" filter:"jump_group_id"`
+	JumpGroupType types.String                 `tfsdk:"jump_group_type" filter:"jump_group_type"`
+	Tag           types.String                 `tfsdk:"tag" filter:"tag"`
 }
 
 func (d *networkTunnelJumpDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
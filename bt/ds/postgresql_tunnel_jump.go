@@ -23,17 +23,17 @@ func newPostgreSQLTunnelJumpDataSource() datasource.DataSource {
 }
 
 type postgresqlTunnelJumpDataSource struct {
-	apiDataSource[postgresqlTunnelJumpDataSourceModel, api.PostgreSQLTunnelJump, models.PostgreSQLTunnelJump]
+	apiDataSource[postgresqlTunnelJumpDataSourceModel, api.PostgreSQLTunnelJump, models.PostgreSQLTunnelJumpDS]
 }
 
 type postgresqlTunnelJumpDataSourceModel struct {
-	Items         []models.PostgreSQLTunnelJump `tfsdk:"items"`
-	Name          types.String                  `tfsdk:"name" filter:"name"`
-	JumpointID    types.Int64                   `tfsdk:"jumpoint_id" filter:"jumpoint_id"`
-	Hostname      types.String                  `tfsdk:"hostname" filter:"hostname"`
-	JumpGroupID   types.Int64                   `tfsdk:"jump_group_id" filter:"jump_group_id"`
-	JumpGroupType types.String                  `tfsdk:"jump_group_type" filter:"jump_group_type"`
-	Tag           types.String                  `tfsdk:"tag" filter:"tag"`
+	Items         []models.PostgreSQLTunnelJumpDS `tfsdk:"items"`
+	Name          types.String                    `tfsdk:"name" filter:"name"`
+	JumpointID    types.Int64                     `tfsdk:"jumpoint_id" filter:"jumpoint_id"`
+	Hostname      types.String                    `tfsdk:"hostname" filter:"hostname"`
+	JumpGroupID   types.Int64                     `tfsdk:"jump_group_id" filter:"jump_group_id"`
+	JumpGroupType types.String                    `tfsdk:"jump_group_type" filter:"jump_group_type"`
+	Tag           types.String                    `tfsdk:"tag" filter:"tag"`
 }
 
 func (d *postgresqlTunnelJumpDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
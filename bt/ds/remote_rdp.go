@@ -21,18 +21,18 @@ func newRemoteRDPDataSource() datasource.DataSource {
 }
 
 type remoteRDPDataSource struct {
-	apiDataSource[remoteRDPDataSourceModel, api.RemoteRDP, models.RemoteRDP]
+	apiDataSource[remoteRDPDataSourceModel, api.RemoteRDP, models.RemoteRDPDS]
 }
 
 type remoteRDPDataSourceModel struct {
-	Items         []models.RemoteRDP `tfsdk:"items"`
-	Name          types.String       `tfsdk:"name" filter:"name"`
-	JumpointID    types.Int64        `tfsdk:"jumpoint_id" filter:"jumpoint_id"`
-	Hostname      types.String       `tfsdk:"hostname" filter:"hostname"`
-	JumpGroupID   types.Int64        `tfsdk:"jump_group_id" filter:"jump_group_id"`
-	JumpGroupType types.String       `tfsdk:"jump_group_type" filter:"jump_group_type"`
-	EndpointID    types.Int64        `tfsdk:"endpoint_id" filter:"endpoint_id"`
-	Tag           types.String       `tfsdk:"tag" filter:"tag"`
+	Items         []models.RemoteRDPDS `tfsdk:"items"`
+	Name          types.String         `tfsdk:"name" filter:"name"`
+	JumpointID    types.Int64          `tfsdk:"jumpoint_id" filter:"jumpoint_id"`
+	Hostname      types.String         `tfsdk:"hostname" filter:"hostname"`
+	JumpGroupID   types.Int64          `tfsdk:"jump_group_id" filter:"jump_group_id"`
+	JumpGroupType types.String         `tfsdk:"jump_group_type" filter:"jump_group_type"`
+	EndpointID    types.Int64          `tfsdk:"endpoint_id" filter:"endpoint_id"`
+	Tag           types.String         `tfsdk:"tag" filter:"tag"`
 }
 
 func (d *remoteRDPDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
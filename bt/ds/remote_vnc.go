@@ -21,17 +21,17 @@ func newRemoteVNCDataSource() datasource.DataSource {
 }
 
 type remoteVNCDataSource struct {
-	apiDataSource[remoteVNCDataSourceModel, api.RemoteVNC, models.RemoteVNC]
+	apiDataSource[remoteVNCDataSourceModel, api.RemoteVNC, models.RemoteVNCDS]
 }
 
 type remoteVNCDataSourceModel struct {
-	Items         []models.RemoteVNC `tfsdk:"items"`
-	Name          types.String       `tfsdk:"name" filter:"name"`
-	JumpointID    types.Int64        `tfsdk:"jumpoint_id" filter:"jumpoint_id"`
-	Hostname      types.String       `tfsdk:"hostname" filter:"hostname"`
-	JumpGroupID   types.Int64        `tfsdk:"jump_group_id" filter:"jump_group_id"`
-	JumpGroupType types.String       `tfsdk:"jump_group_type" filter:"jump_group_type"`
-	Tag           types.String       `tfsdk:"tag" filter:"tag"`
+	Items         []models.RemoteVNCDS `tfsdk:"items"`
+	Name          types.String         `tfsdk:"name" filter:"name"`
+	JumpointID    types.Int64          `tfsdk:"jumpoint_id" filter:"jumpoint_id"`
+	Hostname      types.String         `tfsdk:"hostname" filter:"hostname"`
+	JumpGroupID   types.Int64          `tfsdk:"jump_group_id" filter:"jump_group_id"`
+	JumpGroupType types.String         `tfsdk:"jump_group_type" filter:"jump_group_type"`
+	Tag           types.String         `tfsdk:"tag" filter:"tag"`
 }
 
 func (d *remoteVNCDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
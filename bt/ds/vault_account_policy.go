@@ -21,13 +21,13 @@ func newVaultAccountPolicyDataSource() datasource.DataSource {
 }
 
 type vaultAccountPolicyDataSource struct {
-	apiDataSource[vaultAccountPolicyDataSourceModel, api.VaultAccountPolicy, models.VaultAccountPolicy]
+	apiDataSource[vaultAccountPolicyDataSourceModel, api.VaultAccountPolicy, models.VaultAccountPolicyDS]
 }
 
 type vaultAccountPolicyDataSourceModel struct {
-	Items    []models.VaultAccountPolicy `tfsdk:"items"`
-	Name     types.String                `tfsdk:"name" filter:"name"`
-	CodeName types.String                `tfsdk:"code_name" filter:"code_name"`
+	Items    []models.VaultAccountPolicyDS `tfsdk:"items"`
+	Name     types.String                  `tfsdk:"name" filter:"name"`
+	CodeName types.String                  `tfsdk:"code_name" filter:"code_name"`
 }
 
 func (d *vaultAccountPolicyDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
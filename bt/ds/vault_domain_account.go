@@ -0,0 +1,145 @@
+package ds
+
+import (
+	"context"
+	"strings"
+	"terraform-provider-sra/api"
+	"terraform-provider-sra/bt/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &vaultDomainAccountDataSource{}
+	_ datasource.DataSourceWithConfigure = &vaultDomainAccountDataSource{}
+	_                                    = &vaultDomainAccountDataSourceModel{}
+)
+
+func newVaultDomainAccountDataSource() datasource.DataSource {
+	return &vaultDomainAccountDataSource{}
+}
+
+type vaultDomainAccountDataSource struct {
+	apiDataSource[vaultDomainAccountDataSourceModel, api.VaultDomainAccount, models.VaultDomainAccountDS]
+}
+
+type vaultDomainAccountDataSourceModel struct {
+	Items          []models.VaultDomainAccountDS `tfsdk:"items"`
+	Name           types.String                  `tfsdk:"name" filter:"name"`
+	AccountGroupID types.Int64                   `tfsdk:"account_group_id" filter:"account_group_id"`
+	Domain         types.String                  `tfsdk:"domain"`
+}
+
+func (d *vaultDomainAccountDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetch a list of discovered Windows Domain Vault Accounts.\n\nFor descriptions of individual fields, please see the Configuration API documentation on your SRA Appliance",
+		Attributes: map[string]schema.Attribute{
+			"items": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed: true,
+						},
+						"type": schema.StringAttribute{
+							Computed: true,
+						},
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+						"username": schema.StringAttribute{
+							Computed: true,
+						},
+						"description": schema.StringAttribute{
+							Computed: true,
+						},
+						"last_checkout_timestamp": schema.StringAttribute{
+							Computed: true,
+						},
+						"account_group_id": schema.Int64Attribute{
+							Computed: true,
+						},
+						"account_policy": schema.StringAttribute{
+							Computed: true,
+						},
+						"is_management": schema.BoolAttribute{
+							Computed: true,
+						},
+						"unique_id": schema.StringAttribute{
+							Computed: true,
+						},
+						"distinguished_name": schema.StringAttribute{
+							Computed: true,
+						},
+						"sam_account_name": schema.StringAttribute{
+							Computed: true,
+						},
+						"service_principal_name": schema.StringAttribute{
+							Computed: true,
+						},
+						"azure_ad_object_id": schema.StringAttribute{
+							Computed: true,
+						},
+						"domain": schema.StringAttribute{
+							Description: "The DNS domain of the account, taken from the DC components of the distinguished name",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Filter the list for items matching \"name\"",
+				Optional:    true,
+			},
+			"account_group_id": schema.Int64Attribute{
+				Description: "Filter the list for items in account group with id \"account_group_id\"",
+				Optional:    true,
+			},
+			"domain": schema.StringAttribute{
+				Description: "Filter the list for accounts in the DNS domain \"domain\". This is matched case-insensitively.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (d *vaultDomainAccountDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state vaultDomainAccountDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := api.MakeFilterMap(state)
+	filter["type"] = "windows_domain"
+
+	tflog.Debug(ctx, "🙀 list with filter", map[string]interface{}{
+		"data": filter,
+	})
+
+	items := d.doFilteredRead(ctx, req, resp, filter)
+	if items == nil {
+		return
+	}
+
+	// The API can't filter by domain, so that is done here
+	state.Items = []models.VaultDomainAccountDS{}
+	for _, item := range items {
+		domain := api.DomainFromDistinguishedName(item.DistinguishedName.ValueString())
+		item.Domain = types.StringValue(domain)
+		if !state.Domain.IsNull() && !strings.EqualFold(state.Domain.ValueString(), domain) {
+			continue
+		}
+		state.Items = append(state.Items, item)
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,85 @@
+package ds
+
+import (
+	"context"
+	"terraform-provider-sra/api"
+	"terraform-provider-sra/bt/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &vaultEndpointDataSource{}
+	_ datasource.DataSourceWithConfigure = &vaultEndpointDataSource{}
+	_                                    = &vaultEndpointDataSourceModel{}
+)
+
+func newVaultEndpointDataSource() datasource.DataSource {
+	return &vaultEndpointDataSource{}
+}
+
+type vaultEndpointDataSource struct {
+	apiDataSource[vaultEndpointDataSourceModel, api.VaultEndpoint, models.VaultEndpoint]
+}
+
+type vaultEndpointDataSourceModel struct {
+	Items       []models.VaultEndpoint `tfsdk:"items"`
+	Name        types.String           `tfsdk:"name" filter:"name"`
+	Hostname    types.String           `tfsdk:"hostname" filter:"hostname"`
+	Description types.String           `tfsdk:"description" filter:"description"`
+	DomainName  types.String           `tfsdk:"domain_name" filter:"domain_name"`
+}
+
+func (d *vaultEndpointDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetch a list of Vault Endpoints.\n\nFor descriptions of individual fields, please see the Configuration API documentation on your SRA Appliance",
+		Attributes: map[string]schema.Attribute{
+			"items": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed: true,
+						},
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+						"operating_system": schema.StringAttribute{
+							Computed: true,
+						},
+						"domain_name": schema.StringAttribute{
+							Computed: true,
+						},
+						"distinguished_name": schema.StringAttribute{
+							Computed: true,
+						},
+						"hostname": schema.StringAttribute{
+							Computed: true,
+						},
+						"description": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Filter the list for endpoints matching \"name\"",
+				Optional:    true,
+			},
+			"hostname": schema.StringAttribute{
+				Description: "Filter the list for endpoints with a matching \"hostname\"",
+				Optional:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Filter the list for endpoints with a matching \"description\"",
+				Optional:    true,
+			},
+			"domain_name": schema.StringAttribute{
+				Description: "Filter the list for endpoints in the domain \"domain_name\"",
+				Optional:    true,
+			},
+		},
+	}
+}
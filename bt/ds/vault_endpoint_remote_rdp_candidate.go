@@ -0,0 +1,76 @@
+package ds
+
+import (
+	"context"
+	"terraform-provider-sra/api"
+	"terraform-provider-sra/bt/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &vaultEndpointRemoteRDPCandidateDataSource{}
+	_ datasource.DataSourceWithConfigure = &vaultEndpointRemoteRDPCandidateDataSource{}
+	_                                    = &vaultEndpointRemoteRDPCandidateDataSourceModel{}
+)
+
+func newVaultEndpointRemoteRDPCandidateDataSource() datasource.DataSource {
+	return &vaultEndpointRemoteRDPCandidateDataSource{}
+}
+
+type vaultEndpointRemoteRDPCandidateDataSource struct {
+	apiDataSource[vaultEndpointRemoteRDPCandidateDataSourceModel, api.VaultEndpointRemoteRDPCandidate, models.VaultEndpointRemoteRDPCandidate]
+}
+
+type vaultEndpointRemoteRDPCandidateDataSourceModel struct {
+	Items      []models.VaultEndpointRemoteRDPCandidate `tfsdk:"items"`
+	EndpointID types.Int64                              `tfsdk:"endpoint_id"`
+}
+
+func (d *vaultEndpointRemoteRDPCandidateDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetch a list of the Remote RDP Jump Items that can be associated with a Vault Endpoint.\n\nFor descriptions of individual fields, please see the Configuration API documentation on your SRA Appliance",
+		Attributes: map[string]schema.Attribute{
+			"items": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the Remote RDP Jump Item",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"endpoint_id": schema.Int64Attribute{
+				Description: "The ID of the Vault Endpoint to list the candidates of",
+				Required:    true,
+			},
+		},
+	}
+}
+
+func (d *vaultEndpointRemoteRDPCandidateDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state vaultEndpointRemoteRDPCandidateDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpointID := int(state.EndpointID.ValueInt64())
+	tmp := api.VaultEndpointRemoteRDPCandidate{EndpointID: &endpointID}
+	items := d.doFilteredReadEndpoint(ctx, resp, tmp.Endpoint(), nil)
+	if items == nil {
+		return
+	}
+	state.Items = items
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
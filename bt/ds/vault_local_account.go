@@ -0,0 +1,118 @@
+package ds
+
+import (
+	"context"
+	"terraform-provider-sra/api"
+	"terraform-provider-sra/bt/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &vaultLocalAccountDataSource{}
+	_ datasource.DataSourceWithConfigure = &vaultLocalAccountDataSource{}
+	_                                    = &vaultLocalAccountDataSourceModel{}
+)
+
+func newVaultLocalAccountDataSource() datasource.DataSource {
+	return &vaultLocalAccountDataSource{}
+}
+
+type vaultLocalAccountDataSource struct {
+	apiDataSource[vaultLocalAccountDataSourceModel, api.VaultLocalAccount, models.VaultLocalAccountDS]
+}
+
+type vaultLocalAccountDataSourceModel struct {
+	Items          []models.VaultLocalAccountDS `tfsdk:"items"`
+	Name           types.String                 `tfsdk:"name" filter:"name"`
+	AccountGroupID types.Int64                  `tfsdk:"account_group_id" filter:"account_group_id"`
+	EndpointID     types.Int64                  `tfsdk:"endpoint_id" filter:"endpoint_id"`
+}
+
+func (d *vaultLocalAccountDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetch a list of discovered Windows Local Vault Accounts.\n\nFor descriptions of individual fields, please see the Configuration API documentation on your SRA Appliance",
+		Attributes: map[string]schema.Attribute{
+			"items": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed: true,
+						},
+						"type": schema.StringAttribute{
+							Computed: true,
+						},
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+						"username": schema.StringAttribute{
+							Computed: true,
+						},
+						"description": schema.StringAttribute{
+							Computed: true,
+						},
+						"last_checkout_timestamp": schema.StringAttribute{
+							Computed: true,
+						},
+						"account_group_id": schema.Int64Attribute{
+							Computed: true,
+						},
+						"account_policy": schema.StringAttribute{
+							Computed: true,
+						},
+						"sid": schema.StringAttribute{
+							Computed: true,
+						},
+						"endpoint_id": schema.Int64Attribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Filter the list for items matching \"name\"",
+				Optional:    true,
+			},
+			"account_group_id": schema.Int64Attribute{
+				Description: "Filter the list for items in account group with id \"account_group_id\"",
+				Optional:    true,
+			},
+			"endpoint_id": schema.Int64Attribute{
+				Description: "Filter the list for accounts on the Endpoint with id \"endpoint_id\"",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (d *vaultLocalAccountDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state vaultLocalAccountDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := api.MakeFilterMap(state)
+	filter["type"] = "windows_local"
+
+	tflog.Debug(ctx, "🙀 list with filter", map[string]interface{}{
+		"data": filter,
+	})
+
+	items := d.doFilteredRead(ctx, req, resp, filter)
+	if items == nil {
+		return
+	}
+	state.Items = items
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -130,13 +130,7 @@ func (d *vaultSecretDataSource) Read(ctx context.Context, req datasource.ReadReq
 	account.Username = types.StringValue(item.Username)
 	account.Type = types.StringValue(item.Type)
 
-	if item.Type == "ssh" || item.Type == "ssh_ca" {
-		account.Secret = types.StringValue(*item.PrivateKey)
-	} else if item.Type == "opaque_token" {
-		account.Secret = types.StringValue(*item.Token)
-	} else {
-		account.Secret = types.StringValue(*item.Password)
-	}
+	account.Secret = types.StringValue(item.SecretValue())
 
 	if item.SignedPublicCert != nil {
 		account.SignedPublicCert = types.StringValue(*item.SignedPublicCert)
@@ -21,17 +21,17 @@ func newWebJumpDataSource() datasource.DataSource {
 }
 
 type webJumpDataSource struct {
-	apiDataSource[webJumpDataSourceModel, api.WebJump, models.WebJump]
+	apiDataSource[webJumpDataSourceModel, api.WebJump, models.WebJumpDS]
 }
 
 type webJumpDataSourceModel struct {
-	Items         []models.WebJump `tfsdk:"items"`
-	Name          types.String     `tfsdk:"name" filter:"name"`
-	JumpointID    types.Int64      `tfsdk:"jumpoint_id" filter:"jumpoint_id"`
-	URL           types.String     `tfsdk:"url" filter:"url"`
-	JumpGroupID   types.Int64      `tfsdk:"jump_group_id" filter:"jump_group_id"`
-	JumpGroupType types.String     `tfsdk:"jump_group_type" filter:"jump_group_type"`
-	Tag           types.String     `tfsdk:"tag" filter:"tag"`
+	Items         []models.WebJumpDS `tfsdk:"items"`
+	Name          types.String       `tfsdk:"name" filter:"name"`
+	JumpointID    types.Int64        `tfsdk:"jumpoint_id" filter:"jumpoint_id"`
+	URL           types.String       `tfsdk:"url" filter:"url"`
+	JumpGroupID   types.Int64        `tfsdk:"jump_group_id" filter:"jump_group_id"`
+	JumpGroupType types.String       `tfsdk:"jump_group_type" filter:"jump_group_type"`
+	Tag           types.String       `tfsdk:"tag" filter:"tag"`
 }
 
 func (d *webJumpDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
@@ -0,0 +1,13 @@
+package eph
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+)
+
+// Factory function to return the list of all ephemeral resource–generating factories to the main provider
+// Add new ephemeral resource factory functions here.
+func EphemeralResourceList() []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		newVaultSecretEphemeralResource,
+	}
+}
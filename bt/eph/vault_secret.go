@@ -0,0 +1,232 @@
+package eph
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"terraform-provider-sra/api"
+	"terraform-provider-sra/bt/models"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// These throw away variable declarations are to allow the compiler to
+// enforce compliance to these interfaces
+var (
+	_ ephemeral.EphemeralResource              = &vaultSecretEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &vaultSecretEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithRenew     = &vaultSecretEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithClose     = &vaultSecretEphemeralResource{}
+)
+
+// The key used to pass the checked out account from Open to Renew and Close
+const vaultSecretPrivateKey = "checked_out"
+
+func newVaultSecretEphemeralResource() ephemeral.EphemeralResource {
+	return &vaultSecretEphemeralResource{}
+}
+
+type vaultSecretEphemeralResource struct {
+	apiClient *api.APIClient
+}
+
+type vaultSecretCheckout struct {
+	ID                   int   `json:"id"`
+	RenewIntervalMinutes int64 `json:"renew_interval_minutes"`
+}
+
+func (r *vaultSecretEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_vault_secret", req.ProviderTypeName)
+	tflog.Debug(ctx, fmt.Sprintf("🥃 Registered ephemeral resource name [%s]", resp.TypeName))
+}
+
+func (r *vaultSecretEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, _ *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil || r == nil {
+		return
+	}
+
+	r.apiClient = req.ProviderData.(*api.APIClient)
+}
+
+func (r *vaultSecretEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Checks out the secret data for any Vault Account for the duration of a Terraform operation, and checks it back in when Terraform is done with it. The secret is never stored in the plan or state.
+
+NOTE: The API account being used must have permission to check out the account with the provided ID, and the account must be able to be checked out. If the account cannot be checked out, this resource will produce an error.
+
+The API has no way to extend a check out, so when ` + "`renew_interval_minutes`" + ` is set the account is checked out again at that interval to keep the check out active for long running operations.
+
+For descriptions of individual fields, please see the Configuration API documentation on your SRA Appliance`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the Vault Account to check out",
+				Required:    true,
+			},
+			"type": schema.StringAttribute{
+				Computed:    true,
+				Description: "The type of the account that was retrieved",
+			},
+			"username": schema.StringAttribute{
+				Computed:    true,
+				Description: "The data stored in the username field in Vault.",
+			},
+			"secret": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The secret data stored in Vault.",
+			},
+			"signed_public_cert": schema.StringAttribute{
+				Computed:    true,
+				Description: "The signed public cert for a ssh or ssh_ca secret, if one exists.",
+			},
+			"renew_interval_minutes": schema.Int64Attribute{
+				Description: "How often to renew the check out while Terraform is running. The check out is not renewed if this is not set.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *vaultSecretEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data models.VaultSecretEphemeral
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.Atoi(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid account ID",
+			"The account ID ["+data.ID.ValueString()+"] must be a number",
+		)
+		return
+	}
+
+	tflog.Info(ctx, "🥮 checking out account", map[string]interface{}{
+		"id": id,
+	})
+	item, err := r.checkOut(id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error checking out account",
+			"Error checkout out the account with id ID ["+strconv.Itoa(id)+"]. Please ensure the API account can checkout this item.\n"+err.Error(),
+		)
+		return
+	}
+
+	// Record the check out before anything else can fail, so that Close always
+	// checks the account back in
+	checkout := vaultSecretCheckout{ID: id}
+	if !data.RenewIntervalMinutes.IsNull() {
+		checkout.RenewIntervalMinutes = data.RenewIntervalMinutes.ValueInt64()
+	}
+	privateData, _ := json.Marshal(checkout)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, vaultSecretPrivateKey, privateData)...)
+
+	if checkout.RenewIntervalMinutes > 0 {
+		resp.RenewAt = time.Now().Add(time.Duration(checkout.RenewIntervalMinutes) * time.Minute)
+	}
+
+	data.Type = types.StringValue(item.Type)
+	data.Username = types.StringValue(item.Username)
+	data.Secret = types.StringValue(item.SecretValue())
+	data.SignedPublicCert = types.StringPointerValue(item.SignedPublicCert)
+
+	diags = resp.Result.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *vaultSecretEphemeralResource) Renew(ctx context.Context, req ephemeral.RenewRequest, resp *ephemeral.RenewResponse) {
+	checkout, diags := readVaultSecretCheckout(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || checkout == nil {
+		return
+	}
+
+	tflog.Info(ctx, "🥮 renewing account check out", map[string]interface{}{
+		"id": checkout.ID,
+	})
+	if _, err := r.checkOut(checkout.ID); err != nil {
+		resp.Diagnostics.AddError(
+			"Error renewing account check out",
+			"Error checking out the account with id ID ["+strconv.Itoa(checkout.ID)+"] again to renew the check out.\n"+err.Error(),
+		)
+		return
+	}
+
+	resp.RenewAt = time.Now().Add(time.Duration(checkout.RenewIntervalMinutes) * time.Minute)
+}
+
+func (r *vaultSecretEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	checkout, diags := readVaultSecretCheckout(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || checkout == nil {
+		return
+	}
+
+	resp.Diagnostics.Append(r.checkIn(ctx, checkout.ID)...)
+}
+
+// Checking in can fail because the appliance refuses it, for example when the account was already checked
+// in from the console. That is still an error, the account may be left checked out otherwise
+func (r *vaultSecretEphemeralResource) checkIn(ctx context.Context, id int) diag.Diagnostics {
+	var diags diag.Diagnostics
+	tflog.Info(ctx, "🥮 checking in account", map[string]interface{}{
+		"id": id,
+	})
+	item := api.VaultSecret{ID: &id}
+	_, err := api.Post(r.apiClient, "check-in", item, true)
+	if err == nil {
+		return diags
+	}
+
+	detail := "Error checking in the account with id ID [" + strconv.Itoa(id) + "]. Please ensure the account can be checked in.\n" + err.Error()
+	var apiErr *api.APIError
+	if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusUnprocessableEntity {
+		detail = "The appliance refused to check in the account with id ID [" + strconv.Itoa(id) + "], so it may still be checked out. Check it in from the /login interface if needed.\n" + err.Error()
+	}
+	diags.AddError("Error checking in account", detail)
+	return diags
+}
+
+func (r *vaultSecretEphemeralResource) checkOut(id int) (*api.VaultSecret, error) {
+	item := api.VaultSecret{ID: &id}
+	return api.Post(r.apiClient, "check-out", item, false)
+}
+
+type privateStateReader interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+func readVaultSecretCheckout(ctx context.Context, private privateStateReader) (*vaultSecretCheckout, diag.Diagnostics) {
+	data, diags := private.GetKey(ctx, vaultSecretPrivateKey)
+	if diags.HasError() || data == nil {
+		return nil, diags
+	}
+
+	var checkout vaultSecretCheckout
+	if err := json.Unmarshal(data, &checkout); err != nil {
+		diags.AddError("Error reading check out details", err.Error())
+		return nil, diags
+	}
+
+	return &checkout, diags
+}
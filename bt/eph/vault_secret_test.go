@@ -0,0 +1,73 @@
+package eph
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"terraform-provider-sra/api"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/assert"
+)
+
+type testPrivateState map[string][]byte
+
+func (p testPrivateState) GetKey(_ context.Context, key string) ([]byte, diag.Diagnostics) {
+	return p[key], nil
+}
+
+func TestReadVaultSecretCheckout(t *testing.T) {
+	checkout, diags := readVaultSecretCheckout(context.Background(), testPrivateState{
+		vaultSecretPrivateKey: []byte(`{"id":42,"renew_interval_minutes":5}`),
+	})
+	assert.False(t, diags.HasError())
+	assert.Equal(t, &vaultSecretCheckout{ID: 42, RenewIntervalMinutes: 5}, checkout)
+
+	// Nothing was checked out, so there is nothing to check in
+	checkout, diags = readVaultSecretCheckout(context.Background(), testPrivateState{})
+	assert.False(t, diags.HasError())
+	assert.Nil(t, checkout)
+
+	_, diags = readVaultSecretCheckout(context.Background(), testPrivateState{
+		vaultSecretPrivateKey: []byte(`not json`),
+	})
+	assert.True(t, diags.HasError())
+}
+
+func TestCheckIn(t *testing.T) {
+	status := http.StatusNoContent
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if strings.HasSuffix(r.URL.Path, "oauth2/token") {
+			_, err := w.Write([]byte(`{"token_type":"Bearer","expires_in":3600,"access_token":"secret_access_granted"}`))
+			assert.Nil(t, err)
+			return
+		}
+		assert.Equal(t, "/api/config/v1/vault/account/42/check-in", r.URL.Path)
+		w.WriteHeader(status)
+	}))
+	defer ts.Close()
+
+	clientID := "id"
+	clientSecret := "🤐"
+	c, err := api.NewClient(ts.URL, &clientID, &clientSecret)
+	assert.Nil(t, err)
+	c.SetTest(t)
+	r := &vaultSecretEphemeralResource{apiClient: c}
+
+	diags := r.checkIn(context.Background(), 42)
+	assert.False(t, diags.HasError(), diags)
+
+	// A refused check in leaves the account checked out, so it is still an error
+	status = http.StatusUnprocessableEntity
+	diags = r.checkIn(context.Background(), 42)
+	assert.True(t, diags.HasError())
+	assert.Contains(t, diags.Errors()[0].Detail(), "refused to check in")
+
+	status = http.StatusInternalServerError
+	diags = r.checkIn(context.Background(), 42)
+	assert.True(t, diags.HasError())
+	assert.Contains(t, diags.Errors()[0].Detail(), "Please ensure the account can be checked in")
+}
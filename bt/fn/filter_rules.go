@@ -0,0 +1,302 @@
+package fn
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"terraform-provider-sra/api"
+	"terraform-provider-sra/bt/rs"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// These throw away variable declarations are to allow the compiler to
+// enforce compliance to these interfaces
+var (
+	_ function.Function = &filterRulesFunction{}
+)
+
+// The type of a filter_rules entry on sra_network_tunnel_jump, so the result can be assigned directly
+var (
+	filterRuleIPRangeType = types.ObjectType{AttrTypes: map[string]attr.Type{
+		"start": types.StringType,
+		"end":   types.StringType,
+	}}
+	filterRulePortRangeType = types.ObjectType{AttrTypes: map[string]attr.Type{
+		"start": types.Int64Type,
+		"end":   types.Int64Type,
+	}}
+	filterRuleIPAddressesType = types.ObjectType{AttrTypes: map[string]attr.Type{
+		"cidr":  types.StringType,
+		"range": filterRuleIPRangeType,
+		"list":  types.ListType{ElemType: types.StringType},
+	}}
+	filterRulePortsType = types.ObjectType{AttrTypes: map[string]attr.Type{
+		"list":  types.ListType{ElemType: types.Int64Type},
+		"range": filterRulePortRangeType,
+	}}
+	filterRuleType = types.ObjectType{AttrTypes: map[string]attr.Type{
+		"ip_addresses": filterRuleIPAddressesType,
+		"ports":        filterRulePortsType,
+		"protocol":     types.StringType,
+	}}
+)
+
+// The protocols a filter rule can use, spelled the way the API expects them
+var filterRuleProtocols = []string{
+	"HOPOPT", "ICMP", "IGMP", "GGP", "IPinIP", "ST", "TCP", "CBT", "EGP", "IGP", "BBN_RCC_MON", "NVPII", "PUP",
+	"ARGUS", "EMCON", "XNET", "CHAOS", "UDP", "MUX", "DCN_MEAS", "HMP", "PRM", "XNS_IDP", "TRUNK_1", "TRUNK_2",
+	"LEAF_1", "LEAF_2", "RDP", "IRTP", "ISO_TP4", "NETBLT", "MFE_NSP", "MERIT_INP", "DCCP", "ThirdPartyConnect",
+	"IDPR", "XTP", "DDP", "IDPR_CMTP", "TransportProtocol", "IL", "IPv6", "SDRP", "IPv6_Route", "IPv6_Frag",
+	"IDRP", "RSVP", "GRE", "DSR", "BNA", "ESP", "AH", "I_NLSP", "SwIPe", "NARP", "MOBILE", "TLSP", "SKIP",
+	"IPv6_ICMP", "IPv6_NoNxt", "IPv6_Opts", "AnyHostInternal", "CFTP", "AnyLocalNetwork", "SAT_EXPAK",
+	"KRYPTOLAN", "RVD", "IPPC", "AnyDistFilesystem", "SAT_MON", "VISA", "IPCU", "CPNX", "CPHB", "WSN", "PVP",
+	"BR_SAT_MON", "SUN_ND", "WB_MON", "WB_EXPAK", "ISO_IP", "VMTP", "SECURE_VMTP", "VINES", "TTP", "IPTM",
+	"NSFNET_IGP", "DGP", "TCF", "EIGRP", "OSPF", "Sprite_RPC", "LARP", "MTP", "AX25", "OS", "MICP", "SCC_SP",
+	"ETHERIP", "ENCAP", "AnyPrivateEncryption", "GMTP", "IFMP", "PNNI", "PIM", "ARIS", "SCPS", "QNX",
+	"ActiveNetworks", "IPComp", "SNP", "Compaq_Peer", "IPXinIP", "VRRP", "PGM", "AnyZeroHop", "L2TP", "DDX",
+	"IATP", "STP", "SRP", "UTI", "SMP", "SM", "PTP", "ISISoverIPv4", "FIRE", "CRTP", "CRUDP", "SSCOPMCE",
+	"IPLT", "SPS", "PIPE", "SCTP", "FC", "RSVP_E2E_IGNORE", "MobilityHeader", "UDPLite", "MPLSinIP", "manet",
+	"HIP", "Shim6", "WESP", "ROHC", "Ethernet", "AGGFRAG", "NSH", "ANY",
+}
+
+func newFilterRulesFunction() function.Function {
+	return &filterRulesFunction{}
+}
+
+// Builds the filter_rules of a Network Tunnel Jump from rules written as <protocol>:<addresses>[:<ports>]
+type filterRulesFunction struct{}
+
+func (f *filterRulesFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "filter_rules"
+}
+
+func (f *filterRulesFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Build the filter_rules of a Network Tunnel Jump from compact rules",
+		MarkdownDescription: `Builds the ` + "`filter_rules`" + ` of a ` + "`sra_network_tunnel_jump`" + ` from a list of rules written as ` + "`<protocol>:<addresses>[:<ports>]`" + `, checking them with the same rules as the resource.
+
+* ` + "`protocol`" + ` is a protocol name such as ` + "`tcp`" + `, ` + "`udp`" + ` or ` + "`ipv6_icmp`" + `, in any case, or ` + "`*`" + ` for any protocol.
+* ` + "`addresses`" + ` is a CIDR (` + "`10.0.0.0/24`" + `), a range (` + "`10.0.0.1-10.0.0.9`" + `) or a comma separated list (` + "`10.0.0.1,10.0.0.2`" + `). IPv6 addresses must be wrapped in brackets, for example ` + "`[fd00::/64]`" + `.
+* ` + "`ports`" + ` is optional, and is a range (` + "`1000-2000`" + `) or a comma separated list (` + "`443,8443`" + `). Use ` + "`*`" + ` to allow any port, or leave it out for protocols without ports, such as ` + "`icmp`" + `.`,
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:        "rules",
+				Description: "The rules, each in the form <protocol>:<addresses>[:<ports>]",
+				ElementType: types.StringType,
+			},
+		},
+		Return: function.ListReturn{ElementType: filterRuleType},
+	}
+}
+
+func (f *filterRulesFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var rules []types.String
+	resp.Error = req.Arguments.Get(ctx, &rules)
+	if resp.Error != nil {
+		return
+	}
+
+	elems := make([]attr.Value, 0, len(rules))
+	for i, rule := range rules {
+		if rule.IsNull() {
+			resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Filter rule %d is null", i))
+			return
+		}
+		config, err := parseFilterRule(rule.ValueString())
+		if err != nil {
+			resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Filter rule %d (%q) is invalid: %s", i, rule.ValueString(), err))
+			return
+		}
+		obj, diags := types.ObjectValueFrom(ctx, filterRuleType.AttrTypes, config)
+		if diags.HasError() {
+			resp.Error = function.FuncErrorFromDiags(ctx, diags)
+			return
+		}
+		elems = append(elems, obj)
+	}
+
+	result, diags := types.ListValue(filterRuleType, elems)
+	if diags.HasError() {
+		resp.Error = function.FuncErrorFromDiags(ctx, diags)
+		return
+	}
+
+	resp.Error = argumentError(ctx, 0, rs.ValidateFilterRules(ctx, result))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, result)
+}
+
+// Parses a single rule in the form <protocol>:<addresses>[:<ports>]
+func parseFilterRule(rule string) (api.NetworkConfig, error) {
+	config := api.NetworkConfig{
+		IPAddresses: api.IPAddressConfig{
+			CIDR:  types.StringNull(),
+			List:  types.ListNull(types.StringType),
+			Range: types.ObjectNull(filterRuleIPRangeType.AttrTypes),
+		},
+		Ports: types.ObjectNull(filterRulePortsType.AttrTypes),
+	}
+
+	protocol, rest, found := strings.Cut(strings.TrimSpace(rule), ":")
+	if !found {
+		return config, fmt.Errorf("expected <protocol>:<addresses>[:<ports>]")
+	}
+	protocol = strings.TrimSpace(protocol)
+	if protocol == "" {
+		return config, fmt.Errorf("the protocol is missing")
+	}
+	if protocol == "*" {
+		protocol = "ANY"
+	}
+	canonical, err := filterRuleProtocol(protocol)
+	if err != nil {
+		return config, err
+	}
+	config.Protocol = types.StringValue(canonical)
+
+	// IPv6 addresses contain ':', so they must be bracketed to be told apart from the ports
+	var addresses, ports string
+	var hasPorts bool
+	if strings.HasPrefix(rest, "[") {
+		end := strings.Index(rest, "]")
+		if end < 0 {
+			return config, fmt.Errorf("missing ']' after the addresses")
+		}
+		addresses = rest[1:end]
+		if after := rest[end+1:]; after != "" {
+			if !strings.HasPrefix(after, ":") {
+				return config, fmt.Errorf("expected ':' after ']'")
+			}
+			ports, hasPorts = after[1:], true
+		}
+	} else {
+		addresses, ports, hasPorts = strings.Cut(rest, ":")
+	}
+
+	if err := parseFilterRuleAddresses(strings.TrimSpace(addresses), &config.IPAddresses); err != nil {
+		return config, err
+	}
+
+	if hasPorts {
+		portsObj, err := parseFilterRulePorts(strings.TrimSpace(ports))
+		if err != nil {
+			return config, err
+		}
+		config.Ports = portsObj
+	}
+
+	return config, nil
+}
+
+// Protocols are matched regardless of case, but the API only accepts its own spelling, such as IPv6_ICMP
+func filterRuleProtocol(protocol string) (string, error) {
+	for _, p := range filterRuleProtocols {
+		if strings.EqualFold(p, protocol) {
+			return p, nil
+		}
+	}
+	return "", fmt.Errorf("%q is not a known protocol", protocol)
+}
+
+func parseFilterRuleAddresses(addresses string, config *api.IPAddressConfig) error {
+	if addresses == "" {
+		return fmt.Errorf("the addresses are missing")
+	}
+
+	if strings.Contains(addresses, "/") {
+		if _, _, err := net.ParseCIDR(addresses); err != nil {
+			return fmt.Errorf("%q is not a valid CIDR", addresses)
+		}
+		config.CIDR = types.StringValue(addresses)
+		return nil
+	}
+
+	if start, end, found := strings.Cut(addresses, "-"); found {
+		start, end = strings.TrimSpace(start), strings.TrimSpace(end)
+		for _, ip := range []string{start, end} {
+			if net.ParseIP(ip) == nil {
+				return fmt.Errorf("%q is not a valid IP address", ip)
+			}
+		}
+		config.Range = types.ObjectValueMust(filterRuleIPRangeType.AttrTypes, map[string]attr.Value{
+			"start": types.StringValue(start),
+			"end":   types.StringValue(end),
+		})
+		return nil
+	}
+
+	var ips []attr.Value
+	for _, ip := range strings.Split(addresses, ",") {
+		ip = strings.TrimSpace(ip)
+		if net.ParseIP(ip) == nil {
+			return fmt.Errorf("%q is not a valid IP address", ip)
+		}
+		ips = append(ips, types.StringValue(ip))
+	}
+	config.List = types.ListValueMust(types.StringType, ips)
+	return nil
+}
+
+func parseFilterRulePorts(ports string) (types.Object, error) {
+	noRange := types.ObjectNull(filterRulePortRangeType.AttrTypes)
+	noList := types.ListNull(types.Int64Type)
+
+	switch {
+	case ports == "":
+		return types.ObjectNull(filterRulePortsType.AttrTypes), fmt.Errorf("the ports are missing after ':'")
+	case ports == "*":
+		// The API takes an empty list of ports to mean any port
+		return types.ObjectValueMust(filterRulePortsType.AttrTypes, map[string]attr.Value{
+			"list":  types.ListValueMust(types.Int64Type, []attr.Value{}),
+			"range": noRange,
+		}), nil
+	}
+
+	if start, end, found := strings.Cut(ports, "-"); found {
+		startPort, err := parsePort(start)
+		if err != nil {
+			return types.ObjectNull(filterRulePortsType.AttrTypes), err
+		}
+		endPort, err := parsePort(end)
+		if err != nil {
+			return types.ObjectNull(filterRulePortsType.AttrTypes), err
+		}
+		return types.ObjectValueMust(filterRulePortsType.AttrTypes, map[string]attr.Value{
+			"list": noList,
+			"range": types.ObjectValueMust(filterRulePortRangeType.AttrTypes, map[string]attr.Value{
+				"start": types.Int64Value(startPort),
+				"end":   types.Int64Value(endPort),
+			}),
+		}), nil
+	}
+
+	var list []attr.Value
+	for _, p := range strings.Split(ports, ",") {
+		port, err := parsePort(p)
+		if err != nil {
+			return types.ObjectNull(filterRulePortsType.AttrTypes), err
+		}
+		list = append(list, types.Int64Value(port))
+	}
+	return types.ObjectValueMust(filterRulePortsType.AttrTypes, map[string]attr.Value{
+		"list":  types.ListValueMust(types.Int64Type, list),
+		"range": noRange,
+	}), nil
+}
+
+// Range checks are left to rs.ValidateFilterRules so the messages match the resource
+func parsePort(port string) (int64, error) {
+	v, err := strconv.ParseInt(strings.TrimSpace(port), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%q is not a port number", strings.TrimSpace(port))
+	}
+	return v, nil
+}
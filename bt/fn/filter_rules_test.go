@@ -0,0 +1,127 @@
+package fn
+
+import (
+	"context"
+	"testing"
+
+	"terraform-provider-sra/bt/rs"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func runFilterRules(rules ...string) function.RunResponse {
+	var elems []attr.Value
+	for _, r := range rules {
+		elems = append(elems, types.StringValue(r))
+	}
+	resp := function.RunResponse{Result: function.NewResultData(types.ListUnknown(filterRuleType))}
+	newFilterRulesFunction().Run(context.Background(), function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{types.ListValueMust(types.StringType, elems)}),
+	}, &resp)
+	return resp
+}
+
+func TestFilterRules(t *testing.T) {
+	ctx := context.Background()
+
+	resp := runFilterRules("tcp:10.0.0.0/24:443,8443", "udp:10.0.0.1-10.0.0.9:1000-2000", "*:10.0.0.1, 10.0.0.2", "tcp:[fd00::1,fd00::2]:22", "ipv6_icmp:[fd00::/64]", "udplite:10.0.0.1:*")
+	assert.Nil(t, resp.Error)
+
+	var rules []struct {
+		IPAddresses struct {
+			CIDR  types.String `tfsdk:"cidr"`
+			List  []string     `tfsdk:"list"`
+			Range *struct {
+				Start string `tfsdk:"start"`
+				End   string `tfsdk:"end"`
+			} `tfsdk:"range"`
+		} `tfsdk:"ip_addresses"`
+		Ports *struct {
+			List  []int64 `tfsdk:"list"`
+			Range *struct {
+				Start int64 `tfsdk:"start"`
+				End   int64 `tfsdk:"end"`
+			} `tfsdk:"range"`
+		} `tfsdk:"ports"`
+		Protocol string `tfsdk:"protocol"`
+	}
+	assert.False(t, resp.Result.Value().(types.List).ElementsAs(ctx, &rules, false).HasError())
+	assert.Len(t, rules, 6)
+
+	assert.Equal(t, "TCP", rules[0].Protocol)
+	assert.Equal(t, "10.0.0.0/24", rules[0].IPAddresses.CIDR.ValueString())
+	assert.Equal(t, []int64{443, 8443}, rules[0].Ports.List)
+
+	assert.Equal(t, "UDP", rules[1].Protocol)
+	assert.Equal(t, "10.0.0.9", rules[1].IPAddresses.Range.End)
+	assert.Equal(t, int64(2000), rules[1].Ports.Range.End)
+
+	// No ports allows any port
+	assert.Equal(t, "ANY", rules[2].Protocol)
+	assert.Equal(t, []string{"10.0.0.1", "10.0.0.2"}, rules[2].IPAddresses.List)
+	assert.Nil(t, rules[2].Ports)
+
+	assert.Equal(t, []string{"fd00::1", "fd00::2"}, rules[3].IPAddresses.List)
+	assert.Equal(t, []int64{22}, rules[3].Ports.List)
+
+	// Protocols take the API's spelling, whatever case they're written in
+	assert.Equal(t, "IPv6_ICMP", rules[4].Protocol)
+	assert.Nil(t, rules[4].Ports)
+
+	// * is an empty list of ports, which the API takes as any port
+	assert.Equal(t, "UDPLite", rules[5].Protocol)
+	assert.NotNil(t, rules[5].Ports)
+	assert.Empty(t, rules[5].Ports.List)
+	assert.Nil(t, rules[5].Ports.Range)
+}
+
+func TestFilterRulesInvalid(t *testing.T) {
+	for rule, message := range map[string]string{
+		"10.0.0.0/24":           "expected <protocol>:<addresses>[:<ports>]",
+		":10.0.0.0/24":          "the protocol is missing",
+		"tcpip:10.0.0.0/24":     `"tcpip" is not a known protocol`,
+		"tcp:":                  "the addresses are missing",
+		"tcp:10.0.0.0/33":       "is not a valid CIDR",
+		"tcp:10.0.0.1-host":     `"host" is not a valid IP address`,
+		"tcp:10.0.0.1:":         "the ports are missing",
+		"tcp:10.0.0.1:ssh":      `"ssh" is not a port number`,
+		"tcp:[fd00::1":          "missing ']'",
+		"tcp:10.0.0.1:0":        "port values must be between 1 and 65535",
+		"tcp:10.0.0.1:90-80":    "range start must be <= range end",
+		"tcp:10.0.0.1:80-70000": "range start/end must be between 1 and 65535",
+	} {
+		resp := runFilterRules(rule)
+		assert.ErrorContains(t, resp.Error, message, rule)
+	}
+
+	var rules []string
+	for range 51 {
+		rules = append(rules, "tcp:10.0.0.1")
+	}
+	assert.ErrorContains(t, runFilterRules(rules...).Error, "at most 50 rules")
+	assert.ErrorContains(t, runFilterRules().Error, "at least one filter rule")
+}
+
+// The result has to be assignable to filter_rules, so the types must match the resource schema
+func TestFilterRuleType(t *testing.T) {
+	ctx := context.Background()
+	r := rs.ResourceList()
+	var schema resource.SchemaResponse
+	for _, newResource := range r {
+		res := newResource()
+		var metadata resource.MetadataResponse
+		res.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "sra"}, &metadata)
+		if metadata.TypeName == "sra_network_tunnel_jump" {
+			res.Schema(ctx, resource.SchemaRequest{}, &schema)
+		}
+	}
+
+	attrType, diags := schema.Schema.TypeAtPath(ctx, path.Root("filter_rules"))
+	assert.False(t, diags.HasError())
+	assert.Equal(t, types.ListType{ElemType: filterRuleType}, attrType)
+}
@@ -0,0 +1,33 @@
+package fn
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Factory function to return the list of all function–generating factories to the main provider
+// Add new function factory functions here.
+func FunctionList() []func() function.Function {
+	return []func() function.Function{
+		newTunnelDefinitionsFunction,
+		newParseTunnelDefinitionsFunction,
+		newFilterRulesFunction,
+	}
+}
+
+// Turns validation diagnostics into an error on the function argument that was validated, so Terraform
+// points at the call in the configuration
+func argumentError(_ context.Context, position int64, diags diag.Diagnostics) *function.FuncError {
+	if !diags.HasError() {
+		return nil
+	}
+
+	var messages []string
+	for _, d := range diags.Errors() {
+		messages = append(messages, d.Summary()+": "+d.Detail())
+	}
+	return function.NewArgumentFuncError(position, strings.Join(messages, "\n"))
+}
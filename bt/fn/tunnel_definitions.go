@@ -0,0 +1,143 @@
+package fn
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"terraform-provider-sra/bt/models"
+	"terraform-provider-sra/bt/rs"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// These throw away variable declarations are to allow the compiler to
+// enforce compliance to these interfaces
+var (
+	_ function.Function = &tunnelDefinitionsFunction{}
+	_ function.Function = &parseTunnelDefinitionsFunction{}
+)
+
+var tunnelDefinitionType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"local_port":  types.Int64Type,
+	"remote_port": types.Int64Type,
+}}
+
+const tunnelDefinitionsDescription = "Port pairs are objects with a `local_port`, which must be between 0 and 65535, and a `remote_port`, which must be between 1 and 65535."
+
+func newTunnelDefinitionsFunction() function.Function {
+	return &tunnelDefinitionsFunction{}
+}
+
+// Builds the tunnel_definitions string of a Protocol Tunnel Jump from a list of port pairs
+type tunnelDefinitionsFunction struct{}
+
+func (f *tunnelDefinitionsFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "tunnel_definitions"
+}
+
+func (f *tunnelDefinitionsFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Build the tunnel_definitions of a Protocol Tunnel Jump",
+		MarkdownDescription: "Builds the `tunnel_definitions` value of a `sra_protocol_tunnel_jump` from a list of port pairs, checking it with the same rules as the resource. " +
+			tunnelDefinitionsDescription,
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:        "definitions",
+				Description: "The local and remote port pairs of the tunnels",
+				ElementType: tunnelDefinitionType,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *tunnelDefinitionsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var definitions []models.TunnelDefinition
+	resp.Error = req.Arguments.Get(ctx, &definitions)
+	if resp.Error != nil {
+		return
+	}
+
+	if len(definitions) == 0 {
+		resp.Error = function.NewArgumentFuncError(0, "At least one tunnel definition is required")
+		return
+	}
+
+	parts := make([]string, 0, len(definitions)*2)
+	for i, d := range definitions {
+		if d.LocalPort.IsNull() || d.RemotePort.IsNull() {
+			resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Tunnel definition %d must have both local_port and remote_port", i))
+			return
+		}
+		parts = append(parts, strconv.FormatInt(d.LocalPort.ValueInt64(), 10), strconv.FormatInt(d.RemotePort.ValueInt64(), 10))
+	}
+	result := strings.Join(parts, ";")
+
+	resp.Error = argumentError(ctx, 0, rs.ValidateTunnelDefinitions(result))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, result)
+}
+
+func newParseTunnelDefinitionsFunction() function.Function {
+	return &parseTunnelDefinitionsFunction{}
+}
+
+// Parses the tunnel_definitions string of a Protocol Tunnel Jump into a list of port pairs
+type parseTunnelDefinitionsFunction struct{}
+
+func (f *parseTunnelDefinitionsFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_tunnel_definitions"
+}
+
+func (f *parseTunnelDefinitionsFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Parse the tunnel_definitions of a Protocol Tunnel Jump",
+		MarkdownDescription: "Parses the `tunnel_definitions` value of a `sra_protocol_tunnel_jump`, such as `\"22;24;80;8080\"`, into a list of port pairs, checking it with the same rules as the resource. " +
+			tunnelDefinitionsDescription,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "definitions",
+				Description: "Pairs of local and remote ports separated by ';'",
+			},
+		},
+		Return: function.ListReturn{ElementType: tunnelDefinitionType},
+	}
+}
+
+func (f *parseTunnelDefinitionsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var definitions string
+	resp.Error = req.Arguments.Get(ctx, &definitions)
+	if resp.Error != nil {
+		return
+	}
+
+	if strings.TrimSpace(definitions) == "" {
+		resp.Error = function.NewArgumentFuncError(0, "At least one tunnel definition is required")
+		return
+	}
+
+	resp.Error = argumentError(ctx, 0, rs.ValidateTunnelDefinitions(definitions))
+	if resp.Error != nil {
+		return
+	}
+
+	// Validation has already checked every part is a port number
+	parts := strings.Split(definitions, ";")
+	result := make([]models.TunnelDefinition, 0, len(parts)/2)
+	for i := 0; i < len(parts); i += 2 {
+		local, _ := strconv.ParseInt(strings.TrimSpace(parts[i]), 10, 64)
+		remote, _ := strconv.ParseInt(strings.TrimSpace(parts[i+1]), 10, 64)
+		result = append(result, models.TunnelDefinition{
+			LocalPort:  types.Int64Value(local),
+			RemotePort: types.Int64Value(remote),
+		})
+	}
+
+	resp.Error = resp.Result.Set(ctx, result)
+}
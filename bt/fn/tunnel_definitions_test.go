@@ -0,0 +1,72 @@
+package fn
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func tunnelDefinition(local int64, remote int64) attr.Value {
+	return types.ObjectValueMust(tunnelDefinitionType.AttrTypes, map[string]attr.Value{
+		"local_port":  types.Int64Value(local),
+		"remote_port": types.Int64Value(remote),
+	})
+}
+
+func TestTunnelDefinitions(t *testing.T) {
+	ctx := context.Background()
+	f := newTunnelDefinitionsFunction()
+
+	run := func(defs ...attr.Value) function.RunResponse {
+		resp := function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+		f.Run(ctx, function.RunRequest{
+			Arguments: function.NewArgumentsData([]attr.Value{types.ListValueMust(tunnelDefinitionType, defs)}),
+		}, &resp)
+		return resp
+	}
+
+	resp := run(tunnelDefinition(22, 24), tunnelDefinition(0, 8080))
+	assert.Nil(t, resp.Error)
+	assert.Equal(t, types.StringValue("22;24;0;8080"), resp.Result.Value())
+
+	resp = run()
+	assert.ErrorContains(t, resp.Error, "At least one tunnel definition is required")
+
+	// Remote ports can't be 0, but local ports can
+	resp = run(tunnelDefinition(22, 0))
+	assert.ErrorContains(t, resp.Error, "Remote ports must be between 1 and 65535")
+	assert.Equal(t, int64(0), *resp.Error.FunctionArgument)
+
+	resp = run(tunnelDefinition(70000, 22))
+	assert.ErrorContains(t, resp.Error, "Local ports must be between 0 and 65535")
+}
+
+func TestParseTunnelDefinitions(t *testing.T) {
+	ctx := context.Background()
+	f := newParseTunnelDefinitionsFunction()
+
+	run := func(defs string) function.RunResponse {
+		resp := function.RunResponse{Result: function.NewResultData(types.ListUnknown(tunnelDefinitionType))}
+		f.Run(ctx, function.RunRequest{
+			Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(defs)}),
+		}, &resp)
+		return resp
+	}
+
+	resp := run("22;24; 80 ;8080")
+	assert.Nil(t, resp.Error)
+	assert.Equal(t, types.ListValueMust(tunnelDefinitionType, []attr.Value{tunnelDefinition(22, 24), tunnelDefinition(80, 8080)}), resp.Result.Value())
+
+	resp = run("")
+	assert.ErrorContains(t, resp.Error, "At least one tunnel definition is required")
+
+	resp = run("22;24;80")
+	assert.ErrorContains(t, resp.Error, "pairs of local and remote ports")
+
+	resp = run("22;ssh")
+	assert.ErrorContains(t, resp.Error, "must be integers")
+}
@@ -5,12 +5,15 @@ import (
 )
 
 type JumpGroup struct {
-	ID       types.String `tfsdk:"id"`
-	Name     types.String `tfsdk:"name"`
-	CodeName types.String `tfsdk:"code_name"`
-	Comments types.String `tfsdk:"comments"`
+	ID                 types.String `tfsdk:"id"`
+	DeletionProtection types.Bool   `tfsdk:"deletion_protection"`
+	AdoptExisting      types.Bool   `tfsdk:"adopt_existing"`
+	Name               types.String `tfsdk:"name"`
+	CodeName           types.String `tfsdk:"code_name"`
+	Comments           types.String `tfsdk:"comments"`
 
-	GroupPolicyMemberships types.Set `tfsdk:"group_policy_memberships"`
+	GroupPolicyMembershipMode types.String `tfsdk:"group_policy_membership_mode"`
+	GroupPolicyMemberships    types.Set    `tfsdk:"group_policy_memberships"`
 }
 type GroupPolicyJumpGroup struct {
 	GroupPolicyID  types.String `tfsdk:"group_policy_id"`
@@ -18,6 +21,20 @@ type GroupPolicyJumpGroup struct {
 	JumpPolicyID   types.Int64  `tfsdk:"jump_policy_id" sraproduct:"pra"`
 }
 
+type JumpGroupUser struct {
+	ID             types.String `tfsdk:"id"`
+	JumpGroupID    types.Int64  `tfsdk:"jump_group_id"`
+	UserID         types.Int64  `tfsdk:"user_id"`
+	JumpItemRoleID types.Int64  `tfsdk:"jump_item_role_id"`
+	JumpPolicyID   types.Int64  `tfsdk:"jump_policy_id" sraproduct:"pra"`
+}
+
+type JumpGroupUserDS struct {
+	UserID         types.Int64 `tfsdk:"user_id"`
+	JumpItemRoleID types.Int64 `tfsdk:"jump_item_role_id"`
+	JumpPolicyID   types.Int64 `tfsdk:"jump_policy_id" sraproduct:"pra"`
+}
+
 type JumpGroupDS struct {
 	ID       types.String `tfsdk:"id"`
 	Name     types.String `tfsdk:"name"`
@@ -27,6 +44,8 @@ type JumpGroupDS struct {
 
 type Jumpoint struct {
 	ID                        types.String `tfsdk:"id"`
+	DeletionProtection        types.Bool   `tfsdk:"deletion_protection"`
+	AdoptExisting             types.Bool   `tfsdk:"adopt_existing"`
 	Name                      types.String `tfsdk:"name"`
 	CodeName                  types.String `tfsdk:"code_name"`
 	Platform                  types.String `tfsdk:"platform"`
@@ -39,7 +58,8 @@ type Jumpoint struct {
 	ProtocolTunnelEnabled     types.Bool   `tfsdk:"protocol_tunnel_enabled" sraproduct:"pra"`
 	RdpServiceAccountID       types.Int64  `tfsdk:"rdp_service_account_id" sraproduct:"pra"`
 
-	GroupPolicyMemberships types.Set `tfsdk:"group_policy_memberships"`
+	GroupPolicyMembershipMode types.String `tfsdk:"group_policy_membership_mode"`
+	GroupPolicyMemberships    types.Set    `tfsdk:"group_policy_memberships"`
 }
 
 type JumpointDS struct {
@@ -57,6 +77,16 @@ type JumpointDS struct {
 	RdpServiceAccountID       types.Int64  `tfsdk:"rdp_service_account_id" sraproduct:"pra"`
 }
 
+type JumpointUser struct {
+	ID         types.String `tfsdk:"id"`
+	JumpointID types.Int64  `tfsdk:"jumpoint_id"`
+	UserID     types.Int64  `tfsdk:"user_id"`
+}
+
+type JumpointUserDS struct {
+	UserID types.Int64 `tfsdk:"user_id"`
+}
+
 type JumpItemRole struct {
 	ID                     types.String `tfsdk:"id"`
 	Name                   types.String `tfsdk:"name"`
@@ -0,0 +1,34 @@
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type EndpointAutomationJob struct {
+	ID                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	Notes             types.String `tfsdk:"notes"`
+	StartAt           types.String `tfsdk:"start_at"`
+	Tag               types.String `tfsdk:"tag"`
+	OperatingSystem   types.String `tfsdk:"operating_system"`
+	EndpointIDs       types.Set    `tfsdk:"endpoint_ids"`
+	Cmd               types.String `tfsdk:"cmd"`
+	Script            types.String `tfsdk:"script"`
+	ResourceIDs       types.Set    `tfsdk:"resource_ids"`
+	WaitForCompletion types.Bool   `tfsdk:"wait_for_completion"`
+	TimeoutMinutes    types.Int64  `tfsdk:"timeout_minutes"`
+	FinishedTimestamp types.String `tfsdk:"finished_timestamp"`
+	Executions        types.List   `tfsdk:"executions"`
+}
+
+type EndpointAutomationJobExecution struct {
+	EndpointID              types.Int64  `tfsdk:"endpoint_id"`
+	State                   types.String `tfsdk:"state"`
+	StartedRunningTimestamp types.String `tfsdk:"started_running_timestamp"`
+	FinishedTimestamp       types.String `tfsdk:"finished_timestamp"`
+	FinishedType            types.String `tfsdk:"finished_type"`
+	FinishedError           types.String `tfsdk:"finished_error"`
+	ExitCode                types.Int64  `tfsdk:"exit_code"`
+	OutputTail              types.String `tfsdk:"output_tail"`
+	LogFilePath             types.String `tfsdk:"log_file_path"`
+}
@@ -0,0 +1,33 @@
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type GroupPolicyJumpGroupAssociation struct {
+	ID             types.String `tfsdk:"id"`
+	GroupPolicyID  types.String `tfsdk:"group_policy_id"`
+	JumpGroupID    types.Int64  `tfsdk:"jump_group_id"`
+	JumpItemRoleID types.Int64  `tfsdk:"jump_item_role_id"`
+	JumpPolicyID   types.Int64  `tfsdk:"jump_policy_id" sraproduct:"pra"`
+}
+
+type GroupPolicyJumpointAssociation struct {
+	ID            types.String `tfsdk:"id"`
+	GroupPolicyID types.String `tfsdk:"group_policy_id"`
+	JumpointID    types.Int64  `tfsdk:"jumpoint_id"`
+}
+
+type GroupPolicyVaultAccountAssociation struct {
+	ID            types.String `tfsdk:"id"`
+	GroupPolicyID types.String `tfsdk:"group_policy_id"`
+	AccountID     types.Int64  `tfsdk:"account_id"`
+	Role          types.String `tfsdk:"role"`
+}
+
+type GroupPolicyVaultAccountGroupAssociation struct {
+	ID             types.String `tfsdk:"id"`
+	GroupPolicyID  types.String `tfsdk:"group_policy_id"`
+	AccountGroupID types.Int64  `tfsdk:"account_group_id"`
+	Role           types.String `tfsdk:"role"`
+}
@@ -5,6 +5,26 @@ import (
 )
 
 type ShellJump struct {
+	ID              types.String `tfsdk:"id"`
+	CopyFromID      types.Int64  `tfsdk:"copy_from_id"`
+	AdoptExisting   types.Bool   `tfsdk:"adopt_existing"`
+	Name            types.String `tfsdk:"name"`
+	JumpointID      types.Int64  `tfsdk:"jumpoint_id"`
+	Hostname        types.String `tfsdk:"hostname"`
+	Protocol        types.String `tfsdk:"protocol"`
+	Port            types.Int64  `tfsdk:"port"`
+	JumpGroupID     types.Int64  `tfsdk:"jump_group_id"`
+	JumpGroupType   types.String `tfsdk:"jump_group_type"`
+	Terminal        types.String `tfsdk:"terminal"`
+	KeepAlive       types.Int64  `tfsdk:"keep_alive"`
+	Tag             types.String `tfsdk:"tag"`
+	Comments        types.String `tfsdk:"comments"`
+	JumpPolicyID    types.Int64  `tfsdk:"jump_policy_id"`
+	Username        types.String `tfsdk:"username"`
+	SessionPolicyID types.Int64  `tfsdk:"session_policy_id"`
+}
+
+type ShellJumpDS struct {
 	ID              types.String `tfsdk:"id"`
 	Name            types.String `tfsdk:"name"`
 	JumpointID      types.Int64  `tfsdk:"jumpoint_id"`
@@ -23,6 +43,36 @@ type ShellJump struct {
 }
 
 type RemoteRDP struct {
+	ID              types.String `tfsdk:"id"`
+	CopyFromID      types.Int64  `tfsdk:"copy_from_id"`
+	AdoptExisting   types.Bool   `tfsdk:"adopt_existing"`
+	Name            types.String `tfsdk:"name"`
+	JumpointID      types.Int64  `tfsdk:"jumpoint_id"`
+	Hostname        types.String `tfsdk:"hostname"`
+	JumpGroupID     types.Int64  `tfsdk:"jump_group_id"`
+	JumpGroupType   types.String `tfsdk:"jump_group_type"`
+	Quality         types.String `tfsdk:"quality"`
+	Console         types.Bool   `tfsdk:"console"`
+	IgnoreUntrusted types.Bool   `tfsdk:"ignore_untrusted"`
+	Tag             types.String `tfsdk:"tag"`
+	Comments        types.String `tfsdk:"comments"`
+	RdpUsername     types.String `tfsdk:"rdp_username"`
+	Domain          types.String `tfsdk:"domain"`
+	JumpPolicyID    types.Int64  `tfsdk:"jump_policy_id"`
+	SessionPolicyID types.Int64  `tfsdk:"session_policy_id"`
+	EndpointID      types.Int64  `tfsdk:"endpoint_id"`
+
+	SecureAppType    types.String `tfsdk:"secure_app_type" sraproduct:"pra"`
+	RemoteAppName    types.String `tfsdk:"remote_app_name" sraproduct:"pra"`
+	RemoteAppParams  types.String `tfsdk:"remote_app_params" sraproduct:"pra"`
+	RemoteExePath    types.String `tfsdk:"remote_exe_path" sraproduct:"pra"`
+	RemoteExeParams  types.String `tfsdk:"remote_exe_params" sraproduct:"pra"`
+	TargetSystem     types.String `tfsdk:"target_system" sraproduct:"pra"`
+	CredentialType   types.String `tfsdk:"credential_type" sraproduct:"pra"`
+	SessionForensics types.Bool   `tfsdk:"session_forensics" sraproduct:"pra"`
+}
+
+type RemoteRDPDS struct {
 	ID              types.String `tfsdk:"id"`
 	Name            types.String `tfsdk:"name"`
 	JumpointID      types.Int64  `tfsdk:"jumpoint_id"`
@@ -51,6 +101,22 @@ type RemoteRDP struct {
 }
 
 type RemoteVNC struct {
+	ID              types.String `tfsdk:"id"`
+	CopyFromID      types.Int64  `tfsdk:"copy_from_id"`
+	AdoptExisting   types.Bool   `tfsdk:"adopt_existing"`
+	Name            types.String `tfsdk:"name"`
+	JumpointID      types.Int64  `tfsdk:"jumpoint_id"`
+	Hostname        types.String `tfsdk:"hostname"`
+	Port            types.Int64  `tfsdk:"port"`
+	JumpGroupID     types.Int64  `tfsdk:"jump_group_id"`
+	JumpGroupType   types.String `tfsdk:"jump_group_type"`
+	Tag             types.String `tfsdk:"tag"`
+	Comments        types.String `tfsdk:"comments"`
+	JumpPolicyID    types.Int64  `tfsdk:"jump_policy_id"`
+	SessionPolicyID types.Int64  `tfsdk:"session_policy_id"`
+}
+
+type RemoteVNCDS struct {
 	ID              types.String `tfsdk:"id"`
 	Name            types.String `tfsdk:"name"`
 	JumpointID      types.Int64  `tfsdk:"jumpoint_id"`
@@ -65,6 +131,28 @@ type RemoteVNC struct {
 }
 
 type ProtocolTunnelJump struct {
+	ID                  types.String `tfsdk:"id"`
+	CopyFromID          types.Int64  `tfsdk:"copy_from_id"`
+	AdoptExisting       types.Bool   `tfsdk:"adopt_existing"`
+	Name                types.String `tfsdk:"name"`
+	JumpointID          types.Int64  `tfsdk:"jumpoint_id"`
+	Hostname            types.String `tfsdk:"hostname"`
+	JumpGroupID         types.Int64  `tfsdk:"jump_group_id"`
+	JumpGroupType       types.String `tfsdk:"jump_group_type"`
+	Tag                 types.String `tfsdk:"tag"`
+	Comments            types.String `tfsdk:"comments"`
+	JumpPolicyID        types.Int64  `tfsdk:"jump_policy_id"`
+	SessionPolicyID     types.Int64  `tfsdk:"session_policy_id"`
+	TunnelListenAddress types.String `tfsdk:"tunnel_listen_address"`
+	TunnelDefinitions   types.String `tfsdk:"tunnel_definitions"`
+	TunnelType          types.String `tfsdk:"tunnel_type"`
+	Username            types.String `tfsdk:"username"`
+	Database            types.String `tfsdk:"database"`
+	URL                 types.String `tfsdk:"url"`
+	CACertificates      types.String `tfsdk:"ca_certificates"`
+}
+
+type ProtocolTunnelJumpDS struct {
 	ID                  types.String `tfsdk:"id"`
 	Name                types.String `tfsdk:"name"`
 	JumpointID          types.Int64  `tfsdk:"jumpoint_id"`
@@ -84,7 +172,34 @@ type ProtocolTunnelJump struct {
 	CACertificates      types.String `tfsdk:"ca_certificates"`
 }
 
+// One local and remote port pair of a Protocol Tunnel Jump's tunnel_definitions, used by the tunnel definition functions
+type TunnelDefinition struct {
+	LocalPort  types.Int64 `tfsdk:"local_port"`
+	RemotePort types.Int64 `tfsdk:"remote_port"`
+}
+
 type WebJump struct {
+	ID                    types.String `tfsdk:"id"`
+	CopyFromID            types.Int64  `tfsdk:"copy_from_id"`
+	AdoptExisting         types.Bool   `tfsdk:"adopt_existing"`
+	Name                  types.String `tfsdk:"name"`
+	JumpointID            types.Int64  `tfsdk:"jumpoint_id"`
+	URL                   types.String `tfsdk:"url"`
+	UsernameFormat        types.String `tfsdk:"username_format"`
+	VerifyCertificate     types.Bool   `tfsdk:"verify_certificate"`
+	JumpGroupID           types.Int64  `tfsdk:"jump_group_id"`
+	JumpGroupType         types.String `tfsdk:"jump_group_type"`
+	AuthenticationTimeout types.Int64  `tfsdk:"authentication_timeout"`
+	Tag                   types.String `tfsdk:"tag"`
+	Comments              types.String `tfsdk:"comments"`
+	JumpPolicyID          types.Int64  `tfsdk:"jump_policy_id"`
+	UsernameField         types.String `tfsdk:"username_field"`
+	PasswordField         types.String `tfsdk:"password_field"`
+	SubmitField           types.String `tfsdk:"submit_field"`
+	SessionPolicyID       types.Int64  `tfsdk:"session_policy_id"`
+}
+
+type WebJumpDS struct {
 	ID                    types.String `tfsdk:"id"`
 	Name                  types.String `tfsdk:"name"`
 	JumpointID            types.Int64  `tfsdk:"jumpoint_id"`
@@ -139,6 +254,24 @@ type JumpClientInstaller struct {
 
 // PRA-only tunnel jump item types
 type PostgreSQLTunnelJump struct {
+	ID                  types.String `tfsdk:"id"`
+	CopyFromID          types.Int64  `tfsdk:"copy_from_id"`
+	AdoptExisting       types.Bool   `tfsdk:"adopt_existing"`
+	Name                types.String `tfsdk:"name"`
+	JumpointID          types.Int64  `tfsdk:"jumpoint_id"`
+	Hostname            types.String `tfsdk:"hostname"`
+	JumpGroupID         types.Int64  `tfsdk:"jump_group_id"`
+	JumpGroupType       types.String `tfsdk:"jump_group_type"`
+	Tag                 types.String `tfsdk:"tag"`
+	Comments            types.String `tfsdk:"comments"`
+	JumpPolicyID        types.Int64  `tfsdk:"jump_policy_id"`
+	SessionPolicyID     types.Int64  `tfsdk:"session_policy_id"`
+	TunnelListenAddress types.String `tfsdk:"tunnel_listen_address"`
+	Username            types.String `tfsdk:"username"`
+	Database            types.String `tfsdk:"database"`
+}
+
+type PostgreSQLTunnelJumpDS struct {
 	ID                  types.String `tfsdk:"id"`
 	Name                types.String `tfsdk:"name"`
 	JumpointID          types.Int64  `tfsdk:"jumpoint_id"`
@@ -155,6 +288,24 @@ type PostgreSQLTunnelJump struct {
 }
 
 type MySQLTunnelJump struct {
+	ID                  types.String `tfsdk:"id"`
+	CopyFromID          types.Int64  `tfsdk:"copy_from_id"`
+	AdoptExisting       types.Bool   `tfsdk:"adopt_existing"`
+	Name                types.String `tfsdk:"name"`
+	JumpointID          types.Int64  `tfsdk:"jumpoint_id"`
+	Hostname            types.String `tfsdk:"hostname"`
+	JumpGroupID         types.Int64  `tfsdk:"jump_group_id"`
+	JumpGroupType       types.String `tfsdk:"jump_group_type"`
+	Tag                 types.String `tfsdk:"tag"`
+	Comments            types.String `tfsdk:"comments"`
+	JumpPolicyID        types.Int64  `tfsdk:"jump_policy_id"`
+	SessionPolicyID     types.Int64  `tfsdk:"session_policy_id"`
+	TunnelListenAddress types.String `tfsdk:"tunnel_listen_address"`
+	Username            types.String `tfsdk:"username"`
+	Database            types.String `tfsdk:"database"`
+}
+
+type MySQLTunnelJumpDS struct {
 	ID                  types.String `tfsdk:"id"`
 	Name                types.String `tfsdk:"name"`
 	JumpointID          types.Int64  `tfsdk:"jumpoint_id"`
@@ -171,6 +322,21 @@ type MySQLTunnelJump struct {
 }
 
 type NetworkTunnelJump struct {
+	ID              types.String `tfsdk:"id"`
+	CopyFromID      types.Int64  `tfsdk:"copy_from_id"`
+	AdoptExisting   types.Bool   `tfsdk:"adopt_existing"`
+	Name            types.String `tfsdk:"name"`
+	JumpointID      types.Int64  `tfsdk:"jumpoint_id"`
+	JumpGroupID     types.Int64  `tfsdk:"jump_group_id"`
+	JumpGroupType   types.String `tfsdk:"jump_group_type"`
+	Tag             types.String `tfsdk:"tag"`
+	Comments        types.String `tfsdk:"comments"`
+	JumpPolicyID    types.Int64  `tfsdk:"jump_policy_id"`
+	SessionPolicyID types.Int64  `tfsdk:"session_policy_id"`
+	FilterRules     types.List   `tfsdk:"filter_rules"`
+}
+
+type NetworkTunnelJumpDS struct {
 	ID              types.String `tfsdk:"id"`
 	Name            types.String `tfsdk:"name"`
 	JumpointID      types.Int64  `tfsdk:"jumpoint_id"`
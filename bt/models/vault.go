@@ -16,42 +16,52 @@ type VaultAccount struct {
 }
 
 type VaultUsernamePasswordAccount struct {
-	ID             types.String `tfsdk:"id"`
-	Type           types.String `tfsdk:"type"`
-	Name           types.String `tfsdk:"name"`
-	Description    types.String `tfsdk:"description"`
-	Personal       types.Bool   `tfsdk:"personal"`
-	OwnerUserID    types.Int64  `tfsdk:"owner_user_id"`
-	AccountGroupID types.Int64  `tfsdk:"account_group_id"`
-	AccountPolicy  types.String `tfsdk:"account_policy"`
+	ID                 types.String `tfsdk:"id"`
+	DeletionProtection types.Bool   `tfsdk:"deletion_protection"`
+	AdoptExisting      types.Bool   `tfsdk:"adopt_existing"`
+	Type               types.String `tfsdk:"type"`
+	Name               types.String `tfsdk:"name"`
+	Description        types.String `tfsdk:"description"`
+	Personal           types.Bool   `tfsdk:"personal"`
+	OwnerUserID        types.Int64  `tfsdk:"owner_user_id"`
+	AccountGroupID     types.Int64  `tfsdk:"account_group_id"`
+	AccountPolicy      types.String `tfsdk:"account_policy"`
 
 	Username              types.String `tfsdk:"username"`
 	Password              types.String `tfsdk:"password" sra:"persist_state"`
+	PasswordWO            types.String `tfsdk:"password_wo"`
+	PasswordWOVersion     types.Int64  `tfsdk:"password_wo_version"`
 	LastCheckoutTimestamp types.String `tfsdk:"last_checkout_timestamp"`
 
-	JumpItemAssociation    types.Object `tfsdk:"jump_item_association"`
-	GroupPolicyMemberships types.Set    `tfsdk:"group_policy_memberships"`
+	JumpItemAssociation       types.Object `tfsdk:"jump_item_association"`
+	GroupPolicyMembershipMode types.String `tfsdk:"group_policy_membership_mode"`
+	GroupPolicyMemberships    types.Set    `tfsdk:"group_policy_memberships"`
 }
 
 type VaultSSHAccount struct {
-	ID             types.String `tfsdk:"id"`
-	Type           types.String `tfsdk:"type"`
-	Name           types.String `tfsdk:"name"`
-	Description    types.String `tfsdk:"description"`
-	Personal       types.Bool   `tfsdk:"personal"`
-	OwnerUserID    types.Int64  `tfsdk:"owner_user_id"`
-	AccountGroupID types.Int64  `tfsdk:"account_group_id"`
-	AccountPolicy  types.String `tfsdk:"account_policy"`
+	ID                 types.String `tfsdk:"id"`
+	DeletionProtection types.Bool   `tfsdk:"deletion_protection"`
+	AdoptExisting      types.Bool   `tfsdk:"adopt_existing"`
+	Type               types.String `tfsdk:"type"`
+	Name               types.String `tfsdk:"name"`
+	Description        types.String `tfsdk:"description"`
+	Personal           types.Bool   `tfsdk:"personal"`
+	OwnerUserID        types.Int64  `tfsdk:"owner_user_id"`
+	AccountGroupID     types.Int64  `tfsdk:"account_group_id"`
+	AccountPolicy      types.String `tfsdk:"account_policy"`
 
 	Username              types.String `tfsdk:"username"`
 	PublicKey             types.String `tfsdk:"public_key"`
 	PrivateKey            types.String `tfsdk:"private_key" sra:"persist_state"`
+	PrivateKeyWO          types.String `tfsdk:"private_key_wo"`
+	PrivateKeyWOVersion   types.Int64  `tfsdk:"private_key_wo_version"`
 	PrivateKeyPassphrase  types.String `tfsdk:"private_key_passphrase" sra:"persist_state"`
 	PrivateKeyPublicCert  types.String `tfsdk:"private_key_public_cert"`
 	LastCheckoutTimestamp types.String `tfsdk:"last_checkout_timestamp"`
 
-	JumpItemAssociation    types.Object `tfsdk:"jump_item_association"`
-	GroupPolicyMemberships types.Set    `tfsdk:"group_policy_memberships"`
+	JumpItemAssociation       types.Object `tfsdk:"jump_item_association"`
+	GroupPolicyMembershipMode types.String `tfsdk:"group_policy_membership_mode"`
+	GroupPolicyMemberships    types.Set    `tfsdk:"group_policy_memberships"`
 }
 
 type VaultSSHAccountDS struct {
@@ -70,31 +80,70 @@ type VaultSSHAccountDS struct {
 	LastCheckoutTimestamp types.String `tfsdk:"last_checkout_timestamp"`
 }
 
+type VaultDomainAccountDS struct {
+	ID                    types.String `tfsdk:"id"`
+	Type                  types.String `tfsdk:"type"`
+	Name                  types.String `tfsdk:"name"`
+	Username              types.String `tfsdk:"username"`
+	Description           types.String `tfsdk:"description"`
+	LastCheckoutTimestamp types.String `tfsdk:"last_checkout_timestamp"`
+	AccountGroupID        types.Int64  `tfsdk:"account_group_id"`
+	AccountPolicy         types.String `tfsdk:"account_policy"`
+	IsManagement          types.Bool   `tfsdk:"is_management"`
+	UniqueID              types.String `tfsdk:"unique_id"`
+	DistinguishedName     types.String `tfsdk:"distinguished_name"`
+	SamAccountName        types.String `tfsdk:"sam_account_name"`
+	ServicePrincipalName  types.String `tfsdk:"service_principal_name"`
+	AzureAdObjectID       types.String `tfsdk:"azure_ad_object_id"`
+
+	Domain types.String `tfsdk:"domain"`
+}
+
+type VaultLocalAccountDS struct {
+	ID                    types.String `tfsdk:"id"`
+	Type                  types.String `tfsdk:"type"`
+	Name                  types.String `tfsdk:"name"`
+	Username              types.String `tfsdk:"username"`
+	Description           types.String `tfsdk:"description"`
+	LastCheckoutTimestamp types.String `tfsdk:"last_checkout_timestamp"`
+	AccountGroupID        types.Int64  `tfsdk:"account_group_id"`
+	AccountPolicy         types.String `tfsdk:"account_policy"`
+	Sid                   types.String `tfsdk:"sid"`
+	EndpointID            types.Int64  `tfsdk:"endpoint_id"`
+}
+
 type VaultTokenAccount struct {
-	ID             types.String `tfsdk:"id"`
-	Type           types.String `tfsdk:"type"`
-	Name           types.String `tfsdk:"name"`
-	Description    types.String `tfsdk:"description"`
-	Personal       types.Bool   `tfsdk:"personal"`
-	OwnerUserID    types.Int64  `tfsdk:"owner_user_id"`
-	AccountGroupID types.Int64  `tfsdk:"account_group_id"`
-	AccountPolicy  types.String `tfsdk:"account_policy"`
+	ID                 types.String `tfsdk:"id"`
+	DeletionProtection types.Bool   `tfsdk:"deletion_protection"`
+	AdoptExisting      types.Bool   `tfsdk:"adopt_existing"`
+	Type               types.String `tfsdk:"type"`
+	Name               types.String `tfsdk:"name"`
+	Description        types.String `tfsdk:"description"`
+	Personal           types.Bool   `tfsdk:"personal"`
+	OwnerUserID        types.Int64  `tfsdk:"owner_user_id"`
+	AccountGroupID     types.Int64  `tfsdk:"account_group_id"`
+	AccountPolicy      types.String `tfsdk:"account_policy"`
 
 	Token                 types.String `tfsdk:"token" sra:"persist_state"`
+	TokenWO               types.String `tfsdk:"token_wo"`
+	TokenWOVersion        types.Int64  `tfsdk:"token_wo_version"`
 	LastCheckoutTimestamp types.String `tfsdk:"last_checkout_timestamp"`
 
-	JumpItemAssociation    types.Object `tfsdk:"jump_item_association"`
-	GroupPolicyMemberships types.Set    `tfsdk:"group_policy_memberships"`
+	JumpItemAssociation       types.Object `tfsdk:"jump_item_association"`
+	GroupPolicyMembershipMode types.String `tfsdk:"group_policy_membership_mode"`
+	GroupPolicyMemberships    types.Set    `tfsdk:"group_policy_memberships"`
 }
 
 type VaultAccountGroup struct {
 	ID            types.String `tfsdk:"id"`
+	AdoptExisting types.Bool   `tfsdk:"adopt_existing"`
 	Name          types.String `tfsdk:"name"`
 	Description   types.String `tfsdk:"description"`
 	AccountPolicy types.String `tfsdk:"account_policy"`
 
-	JumpItemAssociation    types.Object `tfsdk:"jump_item_association"`
-	GroupPolicyMemberships types.Set    `tfsdk:"group_policy_memberships"`
+	JumpItemAssociation       types.Object `tfsdk:"jump_item_association"`
+	GroupPolicyMembershipMode types.String `tfsdk:"group_policy_membership_mode"`
+	GroupPolicyMemberships    types.Set    `tfsdk:"group_policy_memberships"`
 }
 
 type VaultAccountGroupDS struct {
@@ -105,6 +154,18 @@ type VaultAccountGroupDS struct {
 }
 
 type VaultAccountPolicy struct {
+	ID                        types.String `tfsdk:"id"`
+	AdoptExisting             types.Bool   `tfsdk:"adopt_existing"`
+	Name                      types.String `tfsdk:"name"`
+	CodeName                  types.String `tfsdk:"code_name"`
+	Description               types.String `tfsdk:"description"`
+	AutoRotateCredentials     types.Bool   `tfsdk:"auto_rotate_credentials"`
+	AllowSimultaneousCheckout types.Bool   `tfsdk:"allow_simultaneous_checkout"`
+	ScheduledPasswordRotation types.Bool   `tfsdk:"scheduled_password_rotation"`
+	MaximumPasswordAge        types.Int64  `tfsdk:"maximum_password_age"`
+}
+
+type VaultAccountPolicyDS struct {
 	ID                        types.String `tfsdk:"id"`
 	Name                      types.String `tfsdk:"name"`
 	CodeName                  types.String `tfsdk:"code_name"`
@@ -122,3 +183,76 @@ type VaultSecret struct {
 	Secret           types.String `tfsdk:"secret"`
 	SignedPublicCert types.String `tfsdk:"signed_public_cert"`
 }
+
+type VaultAccountUser struct {
+	ID        types.String `tfsdk:"id"`
+	AccountID types.Int64  `tfsdk:"account_id"`
+	UserID    types.Int64  `tfsdk:"user_id"`
+	Role      types.String `tfsdk:"role"`
+}
+
+type VaultAccountGroupUser struct {
+	ID             types.String `tfsdk:"id"`
+	AccountGroupID types.Int64  `tfsdk:"account_group_id"`
+	UserID         types.Int64  `tfsdk:"user_id"`
+	Role           types.String `tfsdk:"role"`
+}
+
+type VaultEndpoint struct {
+	ID                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	OperatingSystem   types.String `tfsdk:"operating_system"`
+	DomainName        types.String `tfsdk:"domain_name"`
+	DistinguishedName types.String `tfsdk:"distinguished_name"`
+	Hostname          types.String `tfsdk:"hostname"`
+	Description       types.String `tfsdk:"description"`
+}
+
+type VaultEndpointRemoteRDPAssociation struct {
+	ID         types.String `tfsdk:"id"`
+	EndpointID types.Int64  `tfsdk:"endpoint_id"`
+	JumpItemID types.Int64  `tfsdk:"jump_item_id"`
+}
+
+type VaultAccountJumpItemAssociation struct {
+	ID           types.String `tfsdk:"id"`
+	AccountID    types.Int64  `tfsdk:"account_id"`
+	JumpItemID   types.Int64  `tfsdk:"jump_item_id"`
+	JumpItemType types.String `tfsdk:"jump_item_type"`
+}
+
+type VaultAccountSharedJumpGroupAssociation struct {
+	ID                types.String `tfsdk:"id"`
+	AccountID         types.Int64  `tfsdk:"account_id"`
+	SharedJumpGroupID types.Int64  `tfsdk:"shared_jump_group_id"`
+}
+
+type VaultAccountGroupJumpItemAssociation struct {
+	ID             types.String `tfsdk:"id"`
+	AccountGroupID types.Int64  `tfsdk:"account_group_id"`
+	JumpItemID     types.Int64  `tfsdk:"jump_item_id"`
+	JumpItemType   types.String `tfsdk:"jump_item_type"`
+}
+
+type VaultAccountGroupSharedJumpGroupAssociation struct {
+	ID                types.String `tfsdk:"id"`
+	AccountGroupID    types.Int64  `tfsdk:"account_group_id"`
+	SharedJumpGroupID types.Int64  `tfsdk:"shared_jump_group_id"`
+}
+
+type VaultEndpointRemoteRDPCandidate struct {
+	ID types.String `tfsdk:"id"`
+}
+
+type VaultSecretEphemeral struct {
+	ID                   types.String `tfsdk:"id"`
+	Username             types.String `tfsdk:"username"`
+	Type                 types.String `tfsdk:"type"`
+	Secret               types.String `tfsdk:"secret"`
+	SignedPublicCert     types.String `tfsdk:"signed_public_cert"`
+	RenewIntervalMinutes types.Int64  `tfsdk:"renew_interval_minutes"`
+}
+
+type VaultAccountAction struct {
+	AccountID types.String `tfsdk:"account_id"`
+}
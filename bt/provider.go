@@ -6,10 +6,17 @@ import (
 	"os"
 
 	"terraform-provider-sra/api"
+	"terraform-provider-sra/bt/act"
 	"terraform-provider-sra/bt/ds"
+	"terraform-provider-sra/bt/eph"
+	"terraform-provider-sra/bt/fn"
 	"terraform-provider-sra/bt/rs"
 
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -19,7 +26,11 @@ import (
 )
 
 var (
-	_ provider.Provider = &sraProvider{}
+	_ provider.Provider                       = &sraProvider{}
+	_ provider.ProviderWithEphemeralResources = &sraProvider{}
+	_ provider.ProviderWithActions            = &sraProvider{}
+	_ provider.ProviderWithListResources      = &sraProvider{}
+	_ provider.ProviderWithFunctions          = &sraProvider{}
 )
 
 func New() provider.Provider {
@@ -32,6 +43,9 @@ type sraProviderModel struct {
 	Host         types.String `tfsdk:"host"`
 	ClientId     types.String `tfsdk:"client_id"`
 	ClientSecret types.String `tfsdk:"client_secret"`
+
+	OptimisticConcurrency types.Bool `tfsdk:"optimistic_concurrency"`
+	AdoptExisting         types.Bool `tfsdk:"adopt_existing"`
 }
 
 func (p *sraProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -81,6 +95,14 @@ To use the API Account within your Terraform scripts, the hostname, Client ID, a
 				Optional:    true,
 				Sensitive:   true,
 			},
+			"optimistic_concurrency": schema.BoolAttribute{
+				Description: "Before updating or deleting an item, read it again and fail if it was changed outside of Terraform since it was last refreshed. Defaults to false",
+				Optional:    true,
+			},
+			"adopt_existing": schema.BoolAttribute{
+				Description: "When creating Jump Groups, Jumpoints, Jump Items, Vault Accounts, Vault Account Groups and Vault Account Policies, adopt an existing item with the same code name, or name, instead of creating a new one. Resources can override this with their own adopt_existing attribute. Defaults to false",
+				Optional:    true,
+			},
 		},
 	}
 }
@@ -173,11 +195,17 @@ func (p *sraProvider) Configure(ctx context.Context, req provider.ConfigureReque
 		)
 	}
 
+	c.OptimisticConcurrency = config.OptimisticConcurrency.ValueBool()
+	c.AdoptExisting = config.AdoptExisting.ValueBool()
+
 	api.SetProductIsRS(mechs.IsRS())
 	tflog.Info(ctx, fmt.Sprintf("Detected product is RS? [%v]", api.IsRS()))
 
 	resp.DataSourceData = c
 	resp.ResourceData = c
+	resp.EphemeralResourceData = c
+	resp.ActionData = c
+	resp.ListResourceData = c
 
 	tflog.Info(ctx, "Configured BT API client", map[string]any{"success": true})
 }
@@ -189,3 +217,19 @@ func (p *sraProvider) DataSources(_ context.Context) []func() datasource.DataSou
 func (p *sraProvider) Resources(_ context.Context) []func() resource.Resource {
 	return rs.ResourceList()
 }
+
+func (p *sraProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return eph.EphemeralResourceList()
+}
+
+func (p *sraProvider) ListResources(_ context.Context) []func() list.ListResource {
+	return rs.ListResourceList()
+}
+
+func (p *sraProvider) Actions(_ context.Context) []func() action.Action {
+	return act.ActionList()
+}
+
+func (p *sraProvider) Functions(_ context.Context) []func() function.Function {
+	return fn.FunctionList()
+}
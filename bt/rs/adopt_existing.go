@@ -0,0 +1,86 @@
+package rs
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+/*
+With adopt_existing, the generic Create first looks for an item that already matches the one being created,
+so that re-running a partially failed apply, or taking over items created by scripts, doesn't fail on the
+unique names. The item is looked up the same way as when importing, see import_id.go:
+  - by code_name for items that have a code name, like Jump Groups and Jumpoints
+  - by jump group, jump group type and name for Jump Items
+  - by name for everything else, like Vault Accounts
+
+A single match is adopted. Only the fields where it differs from the plan are updated, like in Update, so
+settings of the existing item that aren't managed here are kept. Nothing is adopted when more than one item
+matches.
+*/
+
+func adoptExistingSchema() schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Description: "Adopt an existing item with the same code name, or name, instead of creating a new one. The adopted item is updated to match this configuration, and creating fails if more than one item matches. Defaults to the provider's adopt_existing setting. This is only used when the item is created",
+		Optional:    true,
+	}
+}
+
+// Reports whether Create should adopt an existing item. The resource's adopt_existing takes precedence
+// over the provider's
+func (r *apiResource[TApi, TTf]) adoptExisting(tfObj reflect.Value) bool {
+	field := tfObj.FieldByName("AdoptExisting")
+	if !field.IsValid() {
+		return false
+	}
+	if adopt := field.Interface().(types.Bool); !adopt.IsNull() && !adopt.IsUnknown() {
+		return adopt.ValueBool()
+	}
+
+	return r.ApiClient != nil && r.ApiClient.AdoptExisting
+}
+
+// Returns the ID of the existing item that matches the item being created, or nil if there isn't one
+func (r *apiResource[TApi, TTf]) findExisting(ctx context.Context, item TApi) (*int, error) {
+	itemObj := reflect.ValueOf(item)
+
+	filter := map[string]string{}
+	if codeName := itemObj.FieldByName("CodeName"); codeName.IsValid() && codeName.String() != "" {
+		filter["code_name"] = codeName.String()
+	} else if name := itemObj.FieldByName("Name"); name.IsValid() && name.String() != "" {
+		filter["name"] = name.String()
+		if jumpGroup := itemObj.FieldByName("JumpGroupID"); jumpGroup.IsValid() {
+			filter["jump_group_id"] = strconv.FormatInt(jumpGroup.Int(), 10)
+		}
+		// Shared and personal Jump Groups have separate IDs, so the same ID can be either
+		if jumpGroupType := itemObj.FieldByName("JumpGroupType"); jumpGroupType.IsValid() && jumpGroupType.String() != "" {
+			filter["jump_group_type"] = jumpGroupType.String()
+		}
+	} else {
+		return nil, nil
+	}
+
+	tflog.Debug(ctx, "🙀 looking up existing item to adopt", map[string]interface{}{
+		"data": filter,
+	})
+	matches, err := r.lookupIDs(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, nil
+	case 1:
+		id, _ := strconv.Atoi(matches[0])
+		return &id, nil
+	default:
+		return nil, fmt.Errorf("found %d existing %s items matching %v (IDs %s), only one can be adopted", len(matches), r.printableName(), filter, strings.Join(matches, ", "))
+	}
+}
@@ -0,0 +1,279 @@
+package rs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"terraform-provider-sra/api"
+	"terraform-provider-sra/bt/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Factory function to return the list of all list resource–generating factories to the main provider
+// Add new list resource factory functions here. Each one must match an existing resource, since the
+// results of a query are instances of that resource.
+func ListResourceList() []func() list.ListResource {
+	return []func() list.ListResource{
+		newAPIListResource[jumpGroupListFilter, api.JumpGroup, models.JumpGroup](newJumpGroupResource),
+		newAPIListResource[jumpointListFilter, api.Jumpoint, models.Jumpoint](newJumpointResource),
+
+		newAPIListResource[jumpItemListFilter, api.ProtocolTunnelJump, models.ProtocolTunnelJump](newProtocolTunnelJumpResource),
+		newAPIListResource[remoteRDPListFilter, api.RemoteRDP, models.RemoteRDP](newRemoteRDPResource),
+		newAPIListResource[jumpItemListFilter, api.RemoteVNC, models.RemoteVNC](newRemoteVNCResource),
+		newAPIListResource[jumpItemListFilter, api.ShellJump, models.ShellJump](newShellJumpResource),
+		newAPIListResource[webJumpListFilter, api.WebJump, models.WebJump](newWebJumpResource),
+		newAPIListResource[jumpItemListFilter, api.PostgreSQLTunnelJump, models.PostgreSQLTunnelJump](newPostgreSQLTunnelJumpResource),
+		newAPIListResource[jumpItemListFilter, api.MySQLTunnelJump, models.MySQLTunnelJump](newMySQLTunnelJumpResource),
+		newAPIListResource[networkTunnelJumpListFilter, api.NetworkTunnelJump, models.NetworkTunnelJump](newNetworkTunnelJumpResource),
+
+		newAPIListResource[vaultAccountListFilter, api.VaultSSHAccount, models.VaultSSHAccount](newVaultSSHAccountResource),
+		newAPIListResource[vaultAccountListFilter, api.VaultUsernamePasswordAccount, models.VaultUsernamePasswordAccount](newVaultUsernamePasswordAccountResource),
+		newAPIListResource[vaultAccountListFilter, api.VaultTokenAccount, models.VaultTokenAccount](newVaultTokenAccountResource),
+	}
+}
+
+/*
+The list config models. These are the filters that can be given in the config block of a list block, and are sent to
+the API the same way as the data source filters: the "filter" tag of each field is the name of the query parameter.
+The config schema is derived from these, so only types.String and types.Int64 fields are supported.
+*/
+
+type jumpGroupListFilter struct {
+	Name     types.String `tfsdk:"name" filter:"name"`
+	CodeName types.String `tfsdk:"code_name" filter:"code_name"`
+}
+
+type jumpointListFilter struct {
+	Name      types.String `tfsdk:"name" filter:"name"`
+	CodeName  types.String `tfsdk:"code_name" filter:"code_name"`
+	PublicIp  types.String `tfsdk:"public_ip" filter:"public_ip"`
+	PrivateIp types.String `tfsdk:"private_ip" filter:"private_ip"`
+	Hostname  types.String `tfsdk:"hostname" filter:"hostname"`
+}
+
+type jumpItemListFilter struct {
+	Name          types.String `tfsdk:"name" filter:"name"`
+	JumpointID    types.Int64  `tfsdk:"jumpoint_id" filter:"jumpoint_id"`
+	Hostname      types.String `tfsdk:"hostname" filter:"hostname"`
+	JumpGroupID   types.Int64  `tfsdk:"jump_group_id" filter:"jump_group_id"`
+	JumpGroupType types.String `tfsdk:"jump_group_type" filter:"jump_group_type"`
+	Tag           types.String `tfsdk:"tag" filter:"tag"`
+}
+
+type remoteRDPListFilter struct {
+	Name          types.String `tfsdk:"name" filter:"name"`
+	JumpointID    types.Int64  `tfsdk:"jumpoint_id" filter:"jumpoint_id"`
+	Hostname      types.String `tfsdk:"hostname" filter:"hostname"`
+	JumpGroupID   types.Int64  `tfsdk:"jump_group_id" filter:"jump_group_id"`
+	JumpGroupType types.String `tfsdk:"jump_group_type" filter:"jump_group_type"`
+	EndpointID    types.Int64  `tfsdk:"endpoint_id" filter:"endpoint_id"`
+	Tag           types.String `tfsdk:"tag" filter:"tag"`
+}
+
+type webJumpListFilter struct {
+	Name          types.String `tfsdk:"name" filter:"name"`
+	JumpointID    types.Int64  `tfsdk:"jumpoint_id" filter:"jumpoint_id"`
+	URL           types.String `tfsdk:"url" filter:"url"`
+	JumpGroupID   types.Int64  `tfsdk:"jump_group_id" filter:"jump_group_id"`
+	JumpGroupType types.String `tfsdk:"jump_group_type" filter:"jump_group_type"`
+	Tag           types.String `tfsdk:"tag" filter:"tag"`
+}
+
+type networkTunnelJumpListFilter struct {
+	Name          types.String `tfsdk:"name" filter:"name"`
+	JumpointID    types.Int64  `tfsdk:"jumpoint_id" filter:"jumpoint_id"`
+	JumpGroupID   types.Int64  `tfsdk:"jump_group_id" filter:"jump_group_id"`
+	JumpGroupType types.String `tfsdk:"jump_group_type" filter:"jump_group_type"`
+	Tag           types.String `tfsdk:"tag" filter:"tag"`
+}
+
+type vaultAccountListFilter struct {
+	Name           types.String `tfsdk:"name" filter:"name"`
+	AccountGroupID types.Int64  `tfsdk:"account_group_id" filter:"account_group_id"`
+}
+
+// The base type for list resources, which lets `terraform query` find existing items so they can be imported.
+// Like apiResource, this has generic types for the API model and the Terraform model of the resource, plus
+// a type for the list config model, which holds the filters. The list resource shares its name with the
+// resource it lists, so that is taken from the resource itself.
+type apiListResource[TFilter any, TApi api.APIResource, TTf any] struct {
+	apiResource[TApi, TTf]
+	resource resource.Resource
+}
+
+func newAPIListResource[TFilter any, TApi api.APIResource, TTf any](newResource func() resource.Resource) func() list.ListResource {
+	return func() list.ListResource {
+		return &apiListResource[TFilter, TApi, TTf]{
+			resource: newResource(),
+		}
+	}
+}
+
+func (r *apiListResource[TFilter, TApi, TTf]) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	r.resource.Metadata(ctx, req, resp)
+}
+
+func (r *apiListResource[TFilter, TApi, TTf]) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	attributes := map[string]schema.Attribute{}
+
+	var filter TFilter
+	typ := reflect.TypeOf(filter)
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		name := field.Tag.Get("tfsdk")
+		description := fmt.Sprintf("Only list items matching this %s", strings.ReplaceAll(name, "_", " "))
+
+		switch field.Type {
+		case reflect.TypeOf(types.String{}):
+			attributes[name] = schema.StringAttribute{Description: description, Optional: true}
+		case reflect.TypeOf(types.Int64{}):
+			attributes[name] = schema.Int64Attribute{Description: description, Optional: true}
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Lists existing items so they can be imported with `terraform query`. Every filter is optional, and all items are listed when none are given.\n\nFor descriptions of individual fields, please see the Configuration API documentation on your SRA Appliance",
+		Attributes:  attributes,
+	}
+}
+
+func (r *apiListResource[TFilter, TApi, TTf]) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var item TApi
+	if !api.IsProductAllowed(ctx, item) {
+		var diags diag.Diagnostics
+		diags.AddError(
+			fmt.Sprintf("%s can't be used with a %s resource", api.ProductName(), r.printableName()),
+			fmt.Sprintf("The %s resource can't be used when BT_API_HOST is configured for a %s site.", r.printableName(), api.ProductName()),
+		)
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	var config TFilter
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	filter := api.MakeFilterMap(config)
+	items, err := r.listItems(ctx, filter)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Unable to list %s items", r.printableName()),
+			err.Error(),
+		)
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for i, item := range items {
+			if req.Limit > 0 && int64(i) >= req.Limit {
+				return
+			}
+
+			if !push(r.listResult(ctx, req, item)) {
+				return
+			}
+		}
+	}
+}
+
+func (r *apiListResource[TFilter, TApi, TTf]) listItems(ctx context.Context, filter map[string]string) ([]TApi, error) {
+	tflog.Debug(ctx, "🙀 list with filter", map[string]interface{}{
+		"data": filter,
+	})
+
+	var tmp TApi
+	accountTypes := vaultAccountTypes(tmp)
+	if len(accountTypes) == 0 {
+		return api.ListItems[TApi](r.ApiClient, filter)
+	}
+
+	items := []TApi{}
+	for _, accountType := range accountTypes {
+		filter["type"] = accountType
+		typeItems, err := api.ListItems[TApi](r.ApiClient, filter)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, typeItems...)
+	}
+
+	return items, nil
+}
+
+func (r *apiListResource[TFilter, TApi, TTf]) listResult(ctx context.Context, req list.ListRequest, item TApi) list.ListResult {
+	result := req.NewListResult(ctx)
+
+	rb, _ := json.Marshal(item)
+	tflog.Debug(ctx, "🙀 listed item", map[string]interface{}{
+		"data": string(rb),
+	})
+
+	var tfItem TTf
+	tfObj := reflect.ValueOf(&tfItem).Elem()
+	apiObj := reflect.ValueOf(&item).Elem()
+	api.CopyAPItoTF(ctx, apiObj, tfObj, reflect.TypeOf(item))
+
+	id := tfObj.FieldByName("ID").Interface().(types.String)
+	result.Diagnostics.Append(r.setIdentity(ctx, result.Identity, tfObj)...)
+
+	if name := tfObj.FieldByName("Name"); name.IsValid() {
+		result.DisplayName = name.Interface().(types.String).ValueString()
+	} else {
+		result.DisplayName = id.ValueString()
+	}
+
+	if req.IncludeResource {
+		result.Diagnostics.Append(nullUnsetAttributes(ctx, tfObj, req.ResourceSchema)...)
+		result.Diagnostics.Append(result.Resource.Set(ctx, &tfItem)...)
+	}
+
+	return result
+}
+
+// Fields of the Terraform model that don't come from the API, like group policy memberships, are left as their
+// zero value when copying from the API. Those can't be saved, since they have no type information, so they are
+// replaced with a null value of the type the resource schema has for that attribute.
+func nullUnsetAttributes(ctx context.Context, tfObj reflect.Value, resourceSchema attributeTypeSource) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for i := 0; i < tfObj.NumField(); i++ {
+		field := tfObj.Field(i)
+		name := tfObj.Type().Field(i).Tag.Get("tfsdk")
+		if name == "" || !field.IsZero() {
+			continue
+		}
+
+		attrType, typeDiags := resourceSchema.TypeAtPath(ctx, path.Root(name))
+		if typeDiags.HasError() {
+			// Not in the schema, so it won't be saved anyway
+			continue
+		}
+
+		nullValue, err := attrType.ValueFromTerraform(ctx, tftypes.NewValue(attrType.TerraformType(ctx), nil))
+		if err != nil {
+			diags.AddAttributeError(path.Root(name), "Error setting null value", err.Error())
+			continue
+		}
+		field.Set(reflect.ValueOf(nullValue))
+	}
+
+	return diags
+}
+
+type attributeTypeSource interface {
+	TypeAtPath(ctx context.Context, p path.Path) (attr.Type, diag.Diagnostics)
+}
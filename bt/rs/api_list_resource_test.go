@@ -0,0 +1,110 @@
+package rs
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"terraform-provider-sra/api"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIListResource(t *testing.T) {
+	ctx := context.Background()
+	var queries []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if strings.HasSuffix(r.URL.Path, "oauth2/token") {
+			_, err := w.Write([]byte(`{"token_type":"Bearer","expires_in":3600,"access_token":"secret_access_granted"}`))
+			assert.Nil(t, err)
+		} else if r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "vault/account") {
+			queries = append(queries, r.URL.RawQuery)
+			_, err := w.Write([]byte(`[{"id":` + map[string]string{"ssh": "1", "ssh_ca": "2"}[r.URL.Query().Get("type")] + `,"type":"` + r.URL.Query().Get("type") + `","name":"Account","username":"root","account_group_id":1}]`))
+			assert.Nil(t, err)
+		} else {
+			assert.Fail(t, "Bad request", r.URL)
+		}
+	}))
+	defer ts.Close()
+
+	clientID := "id"
+	clientSecret := "🤐"
+	c, err := api.NewClient(ts.URL, &clientID, &clientSecret)
+	c.SetTest(t)
+	assert.Nil(t, err)
+
+	var listResource list.ListResource
+	for _, newListResource := range ListResourceList() {
+		var metadata resource.MetadataResponse
+		lr := newListResource()
+		lr.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "sra"}, &metadata)
+		if metadata.TypeName == "sra_vault_ssh_account" {
+			listResource = lr
+		}
+	}
+	assert.NotNil(t, listResource)
+	listResource.(list.ListResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{ProviderData: c}, &resource.ConfigureResponse{})
+
+	var configSchema list.ListResourceSchemaResponse
+	listResource.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, &configSchema)
+	config := tfsdk.Config{Schema: configSchema.Schema}
+	config.Raw = tftypes.NewValue(configSchema.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
+		"name":             tftypes.NewValue(tftypes.String, "Account"),
+		"account_group_id": tftypes.NewValue(tftypes.Number, nil),
+	})
+
+	r := newVaultSSHAccountResource().(*vaultSSHAccountResource)
+	var resourceSchema resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &resourceSchema)
+	var identitySchema resource.IdentitySchemaResponse
+	r.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identitySchema)
+
+	req := list.ListRequest{
+		Config:                 config,
+		IncludeResource:        true,
+		ResourceSchema:         resourceSchema.Schema,
+		ResourceIdentitySchema: identitySchema.IdentitySchema,
+	}
+	var stream list.ListResultsStream
+	listResource.List(ctx, req, &stream)
+
+	var ids []string
+	for result := range stream.Results {
+		assert.False(t, result.Diagnostics.HasError(), result.Diagnostics)
+		assert.Equal(t, "Account", result.DisplayName)
+
+		var id types.String
+		result.Identity.GetAttribute(ctx, path.Root("id"), &id)
+		ids = append(ids, id.ValueString())
+
+		var product, accountType types.String
+		result.Identity.GetAttribute(ctx, path.Root("product"), &product)
+		result.Identity.GetAttribute(ctx, path.Root("type"), &accountType)
+		assert.Equal(t, "pra", product.ValueString())
+		assert.Contains(t, []string{"ssh", "ssh_ca"}, accountType.ValueString())
+
+		var username types.String
+		result.Resource.GetAttribute(ctx, path.Root("username"), &username)
+		assert.Equal(t, "root", username.ValueString())
+	}
+
+	// SSH accounts are listed once for each account type, with the filters from the config
+	assert.Equal(t, []string{"1", "2"}, ids)
+	assert.Equal(t, []string{"name=Account&type=ssh", "name=Account&type=ssh_ca"}, queries)
+
+	req.Limit = 1
+	listResource.List(ctx, req, &stream)
+	count := 0
+	for range stream.Results {
+		count++
+	}
+	assert.Equal(t, 1, count)
+}
@@ -11,11 +11,15 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -25,7 +29,11 @@ import (
 func ResourceList() []func() resource.Resource {
 	return []func() resource.Resource{
 		newJumpGroupResource,
+		newJumpGroupUserResource,
 		newJumpointResource,
+		newJumpointUserResource,
+		newGroupPolicyJumpGroupResource,
+		newGroupPolicyJumpointResource,
 
 		newProtocolTunnelJumpResource,
 		newRemoteRDPResource,
@@ -42,6 +50,17 @@ func ResourceList() []func() resource.Resource {
 		newVaultSSHAccountResource,
 		newVaultUsernamePasswordAccountResource,
 		newVaultTokenAccountResource,
+		newVaultAccountUserResource,
+		newVaultAccountGroupUserResource,
+		newVaultEndpointRemoteRDPAssociationResource,
+		newVaultAccountJumpItemAssociationResource,
+		newVaultAccountSharedJumpGroupAssociationResource,
+		newVaultAccountGroupJumpItemAssociationResource,
+		newVaultAccountGroupSharedJumpGroupAssociationResource,
+		newGroupPolicyVaultAccountResource,
+		newGroupPolicyVaultAccountGroupResource,
+
+		newEndpointAutomationJobResource,
 	}
 }
 
@@ -128,7 +147,28 @@ func (r *apiResource[TApi, TTf]) Create(ctx context.Context, req resource.Create
 	tflog.Debug(ctx, "🙀 executing item post", map[string]interface{}{
 		"data": string(rb),
 	})
-	newItem, err := api.CreateItem(r.ApiClient, item)
+	var newItem *TApi
+	var err error
+	var existingID *int
+	if r.adoptExisting(tfObj) {
+		existingID, err = r.findExisting(ctx, item)
+	}
+	switch {
+	case err != nil:
+		// The lookup failed, which is reported below
+	case existingID != nil:
+		tflog.Info(ctx, fmt.Sprintf("Adopting existing %s [%d]", r.printableName(), *existingID))
+		apiObj.FieldByName("ID").Set(reflect.ValueOf(existingID))
+		var current *TApi
+		current, err = api.GetItem[TApi](r.ApiClient, existingID)
+		if err == nil {
+			newItem, err = api.UpdateChangedFields(r.ApiClient, item, *current)
+		}
+	case tfObj.FieldByName("CopyFromID").IsValid() && !tfObj.FieldByName("CopyFromID").Interface().(types.Int64).IsNull():
+		newItem, err = r.createCopy(ctx, int(tfObj.FieldByName("CopyFromID").Interface().(types.Int64).ValueInt64()), item)
+	default:
+		newItem, err = api.CreateItem(r.ApiClient, item)
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating item",
@@ -145,6 +185,38 @@ func (r *apiResource[TApi, TTf]) Create(ctx context.Context, req resource.Create
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(r.setIdentity(ctx, resp.Identity, tfObj)...)
+}
+
+// Creates the item by copying an existing one, then applies the plan to the copy. Settings of the original
+// the provider doesn't manage are kept. The copy is removed again if the plan can't be applied to it
+func (r *apiResource[TApi, TTf]) createCopy(ctx context.Context, copyFromID int, item TApi) (*TApi, error) {
+	apiObj := reflect.ValueOf(&item).Elem()
+	body := api.CopyJumpItem{
+		JumpGroupID:   int(apiObj.FieldByName("JumpGroupID").Int()),
+		JumpGroupType: apiObj.FieldByName("JumpGroupType").String(),
+		Name:          apiObj.FieldByName("Name").String(),
+	}
+
+	tflog.Debug(ctx, "🙀 copying item", map[string]interface{}{
+		"from": copyFromID,
+		"data": body,
+	})
+	id, err := api.CopyItem[TApi](r.ApiClient, copyFromID, body)
+	if err != nil {
+		return nil, fmt.Errorf("copying %s [%d]: %w", r.printableName(), copyFromID, err)
+	}
+
+	apiObj.FieldByName("ID").Set(reflect.ValueOf(&id))
+	newItem, err := api.UpdateItem(r.ApiClient, item)
+	if err != nil {
+		if deleteErr := api.DeleteItem[TApi](r.ApiClient, &id); deleteErr != nil {
+			tflog.Warn(ctx, fmt.Sprintf("Unable to remove copy [%d] of %s [%d]: %s", id, r.printableName(), copyFromID, deleteErr.Error()))
+		}
+		return nil, fmt.Errorf("updating copy [%d] of %s [%d]: %w", id, r.printableName(), copyFromID, err)
+	}
+	return newItem, nil
 }
 
 func (r *apiResource[TApi, TTf]) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -176,6 +248,11 @@ func (r *apiResource[TApi, TTf]) Read(ctx context.Context, req resource.ReadRequ
 		"data": string(rb),
 	})
 
+	if api.IsNotFound(err) {
+		tflog.Warn(ctx, fmt.Sprintf("%s [%d] no longer exists, removing it from state", r.printableName(), id))
+		removeFromState(ctx, resp, r.setIdentity(ctx, resp.Identity, tfObj))
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading item",
@@ -192,6 +269,8 @@ func (r *apiResource[TApi, TTf]) Read(ctx context.Context, req resource.ReadRequ
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(r.setIdentity(ctx, resp.Identity, tfObj)...)
 }
 
 func (r *apiResource[TApi, TTf]) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -212,18 +291,47 @@ func (r *apiResource[TApi, TTf]) Update(ctx context.Context, req resource.Update
 	}
 	tflog.Debug(ctx, fmt.Sprintf("🤬 update plan [%v]", plan))
 
+	// Only the fields that changed since the prior state are sent, so settings changed in the console that
+	// aren't managed here, or are left at their defaults, aren't reverted
+	var state TTf
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.checkUnchanged(ctx, req.State, "update")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tfObj := reflect.ValueOf(&plan).Elem()
 	apiObj := reflect.ValueOf(&item).Elem()
 	api.CopyTFtoAPI(ctx, tfObj, apiObj)
 
-	rb, _ := json.Marshal(item)
+	var prior TApi
+	api.CopyTFtoAPI(ctx, reflect.ValueOf(&state).Elem(), reflect.ValueOf(&prior).Elem())
+
+	tfId := tfObj.FieldByName("ID").Interface().(types.String)
+	id, _ := strconv.Atoi(tfId.ValueString())
+	changed, err := api.ChangedFields(item, prior)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error comparing item with id [%d]", id),
+			"The planned changes couldn't be compared with the prior state: "+err.Error(),
+		)
+		return
+	}
+
+	fields := make([]string, 0, len(changed))
+	for name := range changed {
+		fields = append(fields, name)
+	}
 	tflog.Debug(ctx, "🙀 executing item update", map[string]interface{}{
-		"data": string(rb),
+		"fields": fields,
 	})
-	newItem, err := api.UpdateItem(r.ApiClient, item)
+	newItem, err := api.UpdateFields(r.ApiClient, item, changed)
 	if err != nil {
-		tfId := tfObj.FieldByName("ID").Interface().(types.String)
-		id, _ := strconv.Atoi(tfId.ValueString())
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Error updating item with id [%d]", id),
 			"Unexpected error: "+err.Error(),
@@ -240,6 +348,8 @@ func (r *apiResource[TApi, TTf]) Update(ctx context.Context, req resource.Update
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(r.setIdentity(ctx, resp.Identity, tfObj)...)
 }
 
 func (r *apiResource[TApi, TTf]) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -262,12 +372,30 @@ func (r *apiResource[TApi, TTf]) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 	tflog.Debug(ctx, fmt.Sprintf("🤬 delete state [%v]", state))
-	tflog.Debug(ctx, "deleting")
 
 	tfObj := reflect.ValueOf(&state).Elem()
 	tfId := tfObj.FieldByName("ID").Interface().(types.String)
 	id, _ := strconv.Atoi(tfId.ValueString())
+
+	// See deletion_protection.go
+	if protected := tfObj.FieldByName("DeletionProtection"); protected.IsValid() && protected.Interface().(types.Bool).ValueBool() {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("%s [%d] is protected from deletion", r.printableName(), id),
+			"The item has deletion_protection set. Set deletion_protection to false and apply before destroying or replacing it.",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(r.checkUnchanged(ctx, req.State, "delete")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "deleting")
 	err := api.DeleteItem[TApi](r.ApiClient, &id)
+	if api.IsNotFound(err) {
+		tflog.Debug(ctx, fmt.Sprintf("%s [%d] was already deleted", r.printableName(), id))
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Error deleting item with ID [%d]", id),
@@ -277,7 +405,32 @@ func (r *apiResource[TApi, TTf]) Delete(ctx context.Context, req resource.Delete
 	}
 }
 
-// Generic ImportState implementation that just imports by ID
+// Reports whether Read removed the resource from state because it no longer exists. Resources that
+// wrap the generic Read use this to skip filling in the rest of the state.
+func resourceGone(resp *resource.ReadResponse) bool {
+	return resp.State.Raw.IsNull()
+}
+
+// Generic IdentitySchema implementation. See identity.go for what identifies an item
+func (r *apiResource[TApi, TTf]) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	var tmp TApi
+	resp.IdentitySchema = itemIdentitySchema(len(vaultAccountTypes(tmp)) > 0)
+}
+
+// Sets the identity from the Terraform model of the item
+func (r *apiResource[TApi, TTf]) setIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, tfObj reflect.Value) diag.Diagnostics {
+	diags := setIdentityID(ctx, identity, tfObj.FieldByName("ID").Interface().(types.String))
+
+	var tmp TApi
+	if identity != nil && len(vaultAccountTypes(tmp)) > 0 {
+		diags.Append(identity.SetAttribute(ctx, path.Root("type"), tfObj.FieldByName("Type").Interface().(types.String))...)
+	}
+
+	return diags
+}
+
+// Generic ImportState implementation that imports by ID, or by looking up the ID from a name or code name. See
+// import_id.go for the supported formats. When importing by identity, the ID is taken from the identity.
 func (r *apiResource[TApi, TTf]) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	var item TApi
 	if !api.IsProductAllowed(ctx, item) {
@@ -288,7 +441,23 @@ func (r *apiResource[TApi, TTf]) ImportState(ctx context.Context, req resource.I
 		return
 	}
 
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importID := req.ID
+	if importID == "" && req.Identity != nil {
+		var diags diag.Diagnostics
+		importID, diags = idFromIdentity(ctx, req.Identity)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	id, err := r.resolveImportID(ctx, importID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
 
 func (d *apiResource[TApi, TTf]) printableName() string {
@@ -299,6 +468,25 @@ func (d *apiResource[TApi, TTf]) printableName() string {
 	return api.ToSnakeCase(parts[len(parts)-1])
 }
 
+// Jump Items can be created by copying an existing Jump Item of the same type, see createCopy. Copying from
+// a different Jump Item replaces the copy. Setting copy_from_id on an item that was created without it, or
+// imported, doesn't, since the item can't be told apart from a copy
+func copyFromIDSchema() schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Description: "The ID of an existing Jump Item of the same type to create this one as a copy of. The copy keeps the settings of the original that aren't managed here, such as its Vault associations. This is only used when the Jump Item is created, and changing it to a different Jump Item replaces the copy",
+		Optional:    true,
+		PlanModifiers: []planmodifier.Int64{
+			int64planmodifier.RequiresReplaceIf(
+				func(ctx context.Context, req planmodifier.Int64Request, resp *int64planmodifier.RequiresReplaceIfFuncResponse) {
+					resp.RequiresReplace = !req.StateValue.IsNull() && !req.ConfigValue.IsNull()
+				},
+				"Copying from a different Jump Item replaces the copy",
+				"Copying from a different Jump Item replaces the copy",
+			),
+		},
+	}
+}
+
 // Jump Group type validator
 func jumpGroupTypeValidator() []validator.String {
 	return []validator.String{
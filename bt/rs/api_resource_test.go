@@ -0,0 +1,520 @@
+package rs
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"terraform-provider-sra/api"
+	"terraform-provider-sra/bt/models"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+// Returns a state holding only the given attributes, and an empty identity, for the resource
+func testState(t *testing.T, r resource.ResourceWithIdentity, attrs map[string]any) (tfsdk.State, *tfsdk.ResourceIdentity) {
+	ctx := context.Background()
+	var schema resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schema)
+	var identitySchema resource.IdentitySchemaResponse
+	r.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identitySchema)
+
+	state := tfsdk.State{Schema: schema.Schema, Raw: tftypes.NewValue(schema.Schema.Type().TerraformType(ctx), nil)}
+	for name, value := range attrs {
+		assert.False(t, state.SetAttribute(ctx, path.Root(name), value).HasError())
+	}
+
+	identity := &tfsdk.ResourceIdentity{
+		Schema: identitySchema.IdentitySchema,
+		Raw:    tftypes.NewValue(identitySchema.IdentitySchema.Type().TerraformType(ctx), nil),
+	}
+	return state, identity
+}
+
+func TestReadAndDeleteMissingItems(t *testing.T) {
+	ctx := context.Background()
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.HasSuffix(r.URL.Path, "oauth2/token"):
+			_, err := w.Write([]byte(`{"token_type":"Bearer","expires_in":3600,"access_token":"secret_access_granted"}`))
+			assert.Nil(t, err)
+		case strings.HasSuffix(r.URL.Path, "/404"):
+			w.WriteHeader(http.StatusNotFound)
+			_, err := w.Write([]byte(`{"message":"Not Found"}`))
+			assert.Nil(t, err)
+		case strings.HasSuffix(r.URL.Path, "/500"):
+			w.WriteHeader(http.StatusInternalServerError)
+			_, err := w.Write([]byte(`{"message":"Oops"}`))
+			assert.Nil(t, err)
+		default:
+			assert.Fail(t, "Bad request", r.URL)
+		}
+	}))
+	defer ts.Close()
+
+	clientID := "id"
+	clientSecret := "🤐"
+	c, err := api.NewClient(ts.URL, &clientID, &clientSecret)
+	c.SetTest(t)
+	assert.Nil(t, err)
+
+	shellJump := newShellJumpResource().(*shellJumpResource)
+	shellJump.ApiClient = c
+	jumpGroupUser := newJumpGroupUserResource().(*jumpGroupUserResource)
+	jumpGroupUser.ApiClient = c
+
+	for _, tc := range []struct {
+		r     resource.ResourceWithIdentity
+		attrs map[string]any
+	}{
+		{shellJump, map[string]any{"id": "404"}},
+		{jumpGroupUser, map[string]any{"id": "3/404", "jump_group_id": int64(3), "user_id": int64(404)}},
+	} {
+		// Items deleted outside of Terraform are removed from state, keeping their identity
+		state, identity := testState(t, tc.r, tc.attrs)
+		resp := resource.ReadResponse{State: state, Identity: identity}
+		tc.r.Read(ctx, resource.ReadRequest{State: state}, &resp)
+		assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+		assert.True(t, resp.State.Raw.IsNull())
+		var id types.String
+		resp.Identity.GetAttribute(ctx, path.Root("id"), &id)
+		assert.Equal(t, tc.attrs["id"], id.ValueString())
+
+		// Deleting them again isn't an error
+		deleteResp := resource.DeleteResponse{State: state}
+		tc.r.Delete(ctx, resource.DeleteRequest{State: state}, &deleteResp)
+		assert.False(t, deleteResp.Diagnostics.HasError(), deleteResp.Diagnostics)
+	}
+
+	for _, tc := range []struct {
+		r     resource.ResourceWithIdentity
+		attrs map[string]any
+	}{
+		{shellJump, map[string]any{"id": "500"}},
+		{jumpGroupUser, map[string]any{"id": "3/500", "jump_group_id": int64(3), "user_id": int64(500)}},
+	} {
+		// Any other error still fails
+		state, identity := testState(t, tc.r, tc.attrs)
+		resp := resource.ReadResponse{State: state, Identity: identity}
+		tc.r.Read(ctx, resource.ReadRequest{State: state}, &resp)
+		assert.True(t, resp.Diagnostics.HasError())
+		assert.False(t, resp.State.Raw.IsNull())
+
+		deleteResp := resource.DeleteResponse{State: state}
+		tc.r.Delete(ctx, resource.DeleteRequest{State: state}, &deleteResp)
+		assert.True(t, deleteResp.Diagnostics.HasError())
+	}
+}
+
+func TestCreateCopy(t *testing.T) {
+	ctx := context.Background()
+	requests := []string{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if strings.HasSuffix(r.URL.Path, "oauth2/token") {
+			_, err := w.Write([]byte(`{"token_type":"Bearer","expires_in":3600,"access_token":"secret_access_granted"}`))
+			assert.Nil(t, err)
+			return
+		}
+		requests = append(requests, r.Method+" "+strings.TrimPrefix(r.URL.Path, "/api/config/v1/"))
+		body, err := io.ReadAll(r.Body)
+		assert.Nil(t, err)
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/copy"):
+			assert.JSONEq(t, `{"jump_group_id":2,"jump_group_type":"shared","name":"copy"}`, string(body))
+			_, err = w.Write([]byte(`{"action":"copy","success":"1","destId":9}`))
+		case r.Method == http.MethodPatch && strings.Contains(string(body), `"hostname":"broken"`):
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			_, err = w.Write([]byte(`{"message":"Invalid hostname"}`))
+		case r.Method == http.MethodPatch:
+			_, err = w.Write([]byte(strings.Replace(string(body), "{", `{"id":9,`, 1)))
+		case r.Method == http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			assert.Fail(t, "Bad request", r.URL)
+		}
+		assert.Nil(t, err)
+	}))
+	defer ts.Close()
+
+	clientID := "id"
+	clientSecret := "🤐"
+	c, err := api.NewClient(ts.URL, &clientID, &clientSecret)
+	c.SetTest(t)
+	assert.Nil(t, err)
+
+	r := newShellJumpResource().(*shellJumpResource)
+	r.ApiClient = c
+
+	create := func(hostname string) resource.CreateResponse {
+		plan, _ := testState(t, r, map[string]any{
+			"copy_from_id":    int64(4),
+			"name":            "copy",
+			"hostname":        hostname,
+			"jump_group_id":   int64(2),
+			"jump_group_type": "shared",
+		})
+		state, identity := testState(t, r, nil)
+		resp := resource.CreateResponse{State: state, Identity: identity}
+		r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw}}, &resp)
+		return resp
+	}
+
+	// The copy is made first, and the rest of the plan is applied to it
+	resp := create("db.example.local")
+	assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	assert.Equal(t, []string{"POST jump-item/shell-jump/4/copy", "PATCH jump-item/shell-jump/9"}, requests)
+
+	var tf models.ShellJump
+	assert.False(t, resp.State.Get(ctx, &tf).HasError())
+	assert.Equal(t, "9", tf.ID.ValueString())
+	assert.Equal(t, int64(4), tf.CopyFromID.ValueInt64())
+	assert.Equal(t, "db.example.local", tf.Hostname.ValueString())
+
+	// A copy that can't be updated isn't left behind
+	requests = nil
+	resp = create("broken")
+	assert.True(t, resp.Diagnostics.HasError())
+	assert.Equal(t, []string{"POST jump-item/shell-jump/4/copy", "PATCH jump-item/shell-jump/9", "DELETE jump-item/shell-jump/9"}, requests)
+}
+
+func TestCopyFromIDReplaces(t *testing.T) {
+	ctx := context.Background()
+	modifier := copyFromIDSchema().PlanModifiers[0]
+	nonEmpty := tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{})
+
+	for _, c := range []struct {
+		name          string
+		state, config types.Int64
+		replace       bool
+	}{
+		{"copying from another item", types.Int64Value(4), types.Int64Value(5), true},
+		{"set on an imported item", types.Int64Null(), types.Int64Value(5), false},
+		{"no longer set", types.Int64Value(4), types.Int64Null(), false},
+	} {
+		req := planmodifier.Int64Request{
+			State:       tfsdk.State{Raw: nonEmpty},
+			Plan:        tfsdk.Plan{Raw: nonEmpty},
+			StateValue:  c.state,
+			ConfigValue: c.config,
+			PlanValue:   c.config,
+		}
+		resp := planmodifier.Int64Response{PlanValue: c.config}
+		modifier.PlanModifyInt64(ctx, req, &resp)
+		assert.False(t, resp.Diagnostics.HasError(), c.name)
+		assert.Equal(t, c.replace, resp.RequiresReplace, c.name)
+	}
+}
+
+func TestUpdateChangedFieldsOnly(t *testing.T) {
+	ctx := context.Background()
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if strings.HasSuffix(r.URL.Path, "oauth2/token") {
+			_, err := w.Write([]byte(`{"token_type":"Bearer","expires_in":3600,"access_token":"secret_access_granted"}`))
+			assert.Nil(t, err)
+			return
+		}
+		assert.Equal(t, http.MethodPatch, r.Method)
+		assert.True(t, strings.HasSuffix(r.URL.Path, "jump-item/shell-jump/7"), r.URL.Path)
+		body, err := io.ReadAll(r.Body)
+		assert.Nil(t, err)
+		assert.JSONEq(t, `{"hostname":"db2.local"}`, string(body))
+		// Unchanged fields aren't sent, but are still part of the response
+		_, err = w.Write([]byte(`{"id":7,"name":"db","hostname":"db2.local","port":22,"terminal":"xterm","jump_group_id":2,"jump_group_type":"shared"}`))
+		assert.Nil(t, err)
+	}))
+	defer ts.Close()
+
+	clientID := "id"
+	clientSecret := "🤐"
+	c, err := api.NewClient(ts.URL, &clientID, &clientSecret)
+	c.SetTest(t)
+	assert.Nil(t, err)
+
+	r := newShellJumpResource().(*shellJumpResource)
+	r.ApiClient = c
+
+	attrs := map[string]any{
+		"id":              "7",
+		"name":            "db",
+		"hostname":        "db.local",
+		"port":            int64(22),
+		"terminal":        "xterm",
+		"jump_group_id":   int64(2),
+		"jump_group_type": "shared",
+	}
+	state, identity := testState(t, r, attrs)
+	attrs["hostname"] = "db2.local"
+	plan, _ := testState(t, r, attrs)
+
+	resp := resource.UpdateResponse{State: state, Identity: identity}
+	r.Update(ctx, resource.UpdateRequest{Plan: tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw}, State: state}, &resp)
+	assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var tf models.ShellJump
+	assert.False(t, resp.State.Get(ctx, &tf).HasError())
+	assert.Equal(t, "db2.local", tf.Hostname.ValueString())
+	assert.Equal(t, "xterm", tf.Terminal.ValueString())
+}
+
+func TestOptimisticConcurrency(t *testing.T) {
+	ctx := context.Background()
+	remote := `{"id":7,"name":"db","hostname":"db.local","port":22,"terminal":"xterm","jump_group_id":2,"jump_group_type":"shared"}`
+	changes := []string{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if strings.HasSuffix(r.URL.Path, "oauth2/token") {
+			_, err := w.Write([]byte(`{"token_type":"Bearer","expires_in":3600,"access_token":"secret_access_granted"}`))
+			assert.Nil(t, err)
+			return
+		}
+		if r.Method == http.MethodGet {
+			if remote == "" {
+				w.WriteHeader(http.StatusNotFound)
+				_, err := w.Write([]byte(`{"message":"Not Found"}`))
+				assert.Nil(t, err)
+				return
+			}
+			_, err := w.Write([]byte(remote))
+			assert.Nil(t, err)
+			return
+		}
+		changes = append(changes, r.Method)
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		_, err := w.Write([]byte(`{"id":7,"name":"db","hostname":"db2.local","port":22,"terminal":"xterm","jump_group_id":2,"jump_group_type":"shared"}`))
+		assert.Nil(t, err)
+	}))
+	defer ts.Close()
+
+	clientID := "id"
+	clientSecret := "🤐"
+	c, err := api.NewClient(ts.URL, &clientID, &clientSecret)
+	c.SetTest(t)
+	assert.Nil(t, err)
+	c.OptimisticConcurrency = true
+
+	r := newShellJumpResource().(*shellJumpResource)
+	r.ApiClient = c
+
+	attrs := map[string]any{
+		"id":              "7",
+		"name":            "db",
+		"hostname":        "db.local",
+		"port":            int64(22),
+		"terminal":        "xterm",
+		"jump_group_id":   int64(2),
+		"jump_group_type": "shared",
+	}
+	state, identity := testState(t, r, attrs)
+	attrs["hostname"] = "db2.local"
+	plan, _ := testState(t, r, attrs)
+	update := func() resource.UpdateResponse {
+		resp := resource.UpdateResponse{State: state, Identity: identity}
+		r.Update(ctx, resource.UpdateRequest{Plan: tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw}, State: state}, &resp)
+		return resp
+	}
+	remove := func() resource.DeleteResponse {
+		resp := resource.DeleteResponse{State: state}
+		r.Delete(ctx, resource.DeleteRequest{State: state}, &resp)
+		return resp
+	}
+
+	// Unchanged items are updated and deleted as usual
+	resp := update()
+	assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	deleteResp := remove()
+	assert.False(t, deleteResp.Diagnostics.HasError(), deleteResp.Diagnostics)
+	assert.Equal(t, []string{http.MethodPatch, http.MethodDelete}, changes)
+
+	// Changes made elsewhere stop both, and are listed
+	changes = nil
+	remote = `{"id":7,"name":"db","hostname":"db.local","port":2222,"terminal":"xterm","jump_group_id":3,"jump_group_type":"shared"}`
+	resp = update()
+	assert.True(t, resp.Diagnostics.HasError())
+	detail := resp.Diagnostics.Errors()[0].Detail()
+	assert.Contains(t, detail, "jump_group_id: 2 → 3")
+	assert.Contains(t, detail, "port: 22 → 2222")
+	assert.NotContains(t, detail, "hostname")
+	deleteResp = remove()
+	assert.True(t, deleteResp.Diagnostics.HasError())
+	assert.Empty(t, changes)
+
+	// Items that are already gone aren't a conflict
+	remote = ""
+	deleteResp = remove()
+	assert.False(t, deleteResp.Diagnostics.HasError(), deleteResp.Diagnostics)
+	assert.Equal(t, []string{http.MethodDelete}, changes)
+
+	// Nothing is checked unless it's enabled
+	c.OptimisticConcurrency = false
+	remote = `{"id":7,"name":"db","hostname":"db.local","port":2222,"terminal":"xterm","jump_group_id":3,"jump_group_type":"shared"}`
+	changes = nil
+	resp = update()
+	assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	assert.Equal(t, []string{http.MethodPatch}, changes)
+}
+
+func TestAdoptExisting(t *testing.T) {
+	ctx := context.Background()
+	existing := `[{"id":7,"name":"db","hostname":"old.local","port":22,"terminal":"xterm","jump_group_id":2,"jump_group_type":"shared"}]`
+	requests := []string{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if strings.HasSuffix(r.URL.Path, "oauth2/token") {
+			_, err := w.Write([]byte(`{"token_type":"Bearer","expires_in":3600,"access_token":"secret_access_granted"}`))
+			assert.Nil(t, err)
+			return
+		}
+		endpoint := strings.TrimPrefix(r.URL.Path, "/api/config/v1/")
+		switch {
+		case r.Method == http.MethodGet && endpoint == "jump-item/shell-jump/7":
+			requests = append(requests, r.Method+" "+endpoint)
+			_, err := w.Write([]byte(`{"id":7,"name":"db","hostname":"old.local","port":22,"terminal":"xterm","jump_group_id":2,"jump_group_type":"shared"}`))
+			assert.Nil(t, err)
+		case r.Method == http.MethodGet:
+			requests = append(requests, r.Method+" "+endpoint+"?"+r.URL.RawQuery)
+			_, err := w.Write([]byte(existing))
+			assert.Nil(t, err)
+		default:
+			body, err := io.ReadAll(r.Body)
+			assert.Nil(t, err)
+			requests = append(requests, r.Method+" "+endpoint+" "+string(body))
+			_, err = w.Write([]byte(`{"id":7,"name":"db","hostname":"db.local","port":22,"terminal":"xterm","jump_group_id":2,"jump_group_type":"shared"}`))
+			assert.Nil(t, err)
+		}
+	}))
+	defer ts.Close()
+
+	clientID := "id"
+	clientSecret := "🤐"
+	c, err := api.NewClient(ts.URL, &clientID, &clientSecret)
+	c.SetTest(t)
+	assert.Nil(t, err)
+
+	r := newShellJumpResource().(*shellJumpResource)
+	r.ApiClient = c
+
+	attrs := map[string]any{
+		"name":            "db",
+		"hostname":        "db.local",
+		"port":            int64(22),
+		"terminal":        "xterm",
+		"jump_group_id":   int64(2),
+		"jump_group_type": "shared",
+	}
+	create := func() resource.CreateResponse {
+		plan, _ := testState(t, r, attrs)
+		state, identity := testState(t, r, nil)
+		resp := resource.CreateResponse{State: state, Identity: identity}
+		r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw}}, &resp)
+		return resp
+	}
+
+	// Nothing is looked up unless it's enabled
+	resp := create()
+	assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	assert.Len(t, requests, 1)
+	assert.True(t, strings.HasPrefix(requests[0], "POST jump-item/shell-jump "))
+
+	// A single match has the fields that differ from the plan updated, instead of creating a new item
+	c.AdoptExisting = true
+	requests = nil
+	resp = create()
+	assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	assert.Equal(t, []string{
+		"GET jump-item/shell-jump?jump_group_id=2&jump_group_type=shared&name=db",
+		"GET jump-item/shell-jump/7",
+		`PATCH jump-item/shell-jump/7 {"hostname":"db.local"}`,
+	}, requests)
+	var tf models.ShellJump
+	assert.False(t, resp.State.Get(ctx, &tf).HasError())
+	assert.Equal(t, "7", tf.ID.ValueString())
+
+	// Items with only a similar name, or in a personal Jump Group with the same ID, don't match
+	for _, e := range []string{
+		`[{"id":8,"name":"db-old","hostname":"old.local","jump_group_id":2,"jump_group_type":"shared"}]`,
+		`[{"id":8,"name":"db","hostname":"old.local","jump_group_id":2,"jump_group_type":"personal"}]`,
+	} {
+		existing = e
+		requests = nil
+		resp = create()
+		assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+		assert.True(t, strings.HasPrefix(requests[len(requests)-1], "POST jump-item/shell-jump "), e)
+	}
+
+	// Nothing is adopted when several items match
+	existing = `[{"id":8,"name":"db","jump_group_id":2,"jump_group_type":"shared"},{"id":9,"name":"db","jump_group_id":2,"jump_group_type":"shared"}]`
+	requests = nil
+	resp = create()
+	assert.True(t, resp.Diagnostics.HasError())
+	assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), "IDs 8, 9")
+	assert.Len(t, requests, 1)
+
+	// The resource's setting takes precedence over the provider's
+	attrs["adopt_existing"] = false
+	requests = nil
+	resp = create()
+	assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	assert.Len(t, requests, 1)
+	assert.True(t, strings.HasPrefix(requests[0], "POST jump-item/shell-jump "))
+}
+
+func TestAdoptExistingByCodeName(t *testing.T) {
+	ctx := context.Background()
+	requests := []string{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if strings.HasSuffix(r.URL.Path, "oauth2/token") {
+			_, err := w.Write([]byte(`{"token_type":"Bearer","expires_in":3600,"access_token":"secret_access_granted"}`))
+			assert.Nil(t, err)
+			return
+		}
+		requests = append(requests, r.Method+" "+strings.TrimPrefix(r.URL.Path, "/api/config/v1/")+"?"+r.URL.RawQuery)
+		if r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/4") {
+			_, err := w.Write([]byte(`{"id":4,"name":"Old Name","code_name":"servers","comments":""}`))
+			assert.Nil(t, err)
+			return
+		}
+		if r.Method == http.MethodGet {
+			_, err := w.Write([]byte(`[{"id":4,"name":"Old Name","code_name":"servers","comments":""}]`))
+			assert.Nil(t, err)
+			return
+		}
+		_, err := w.Write([]byte(`{"id":4,"name":"Servers","code_name":"servers","comments":""}`))
+		assert.Nil(t, err)
+	}))
+	defer ts.Close()
+
+	clientID := "id"
+	clientSecret := "🤐"
+	c, err := api.NewClient(ts.URL, &clientID, &clientSecret)
+	c.SetTest(t)
+	assert.Nil(t, err)
+
+	r := newJumpGroupResource().(*jumpGroupResource)
+	r.ApiClient = c
+
+	plan, _ := testState(t, r, map[string]any{
+		"adopt_existing": true,
+		"name":           "Servers",
+		"code_name":      "servers",
+	})
+	state, identity := testState(t, r, nil)
+	resp := resource.CreateResponse{State: state, Identity: identity}
+	r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw}}, &resp)
+	assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	assert.Equal(t, []string{"GET jump-group?code_name=servers", "GET jump-group/4?", "PATCH jump-group/4?"}, requests)
+}
@@ -0,0 +1,52 @@
+package rs
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Membership style resources don't have an ID of their own, so they are
+// identified by the IDs of both sides of the membership in the form
+// "<parent_id>/<child_id>". This is also the format used when importing them.
+func compositeID(parentID int, childID int) string {
+	return fmt.Sprintf("%d/%d", parentID, childID)
+}
+
+func parseCompositeID(id string) (int, int, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("expected an ID in the form <parent_id>/<child_id>, got [%s]", id)
+	}
+
+	parentID, err := strconv.Atoi(strings.TrimSpace(parts[0]))
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid parent ID [%s] in [%s]", parts[0], id)
+	}
+	childID, err := strconv.Atoi(strings.TrimSpace(parts[1]))
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid child ID [%s] in [%s]", parts[1], id)
+	}
+
+	return parentID, childID, nil
+}
+
+// Jump Items of different types can have the same ID, so memberships of Jump Items also include the type,
+// in the form "<parent_id>/<type>/<child_id>"
+func typedCompositeID(parentID int, childType string, childID int) string {
+	return fmt.Sprintf("%d/%s/%d", parentID, childType, childID)
+}
+
+func parseTypedCompositeID(id string) (int, string, int, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 3 || strings.TrimSpace(parts[1]) == "" {
+		return 0, "", 0, fmt.Errorf("expected an ID in the form <parent_id>/<type>/<child_id>, got [%s]", id)
+	}
+
+	parentID, childID, err := parseCompositeID(parts[0] + "/" + parts[2])
+	if err != nil {
+		return 0, "", 0, fmt.Errorf("expected an ID in the form <parent_id>/<type>/<child_id>, got [%s]", id)
+	}
+
+	return parentID, strings.TrimSpace(parts[1]), childID, nil
+}
@@ -0,0 +1,34 @@
+package rs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCompositeID(t *testing.T) {
+	parent, child, err := parseCompositeID("12/34")
+	assert.NoError(t, err)
+	assert.Equal(t, 12, parent)
+	assert.Equal(t, 34, child)
+	assert.Equal(t, "12/34", compositeID(parent, child))
+
+	for _, bad := range []string{"", "12", "12/34/56", "a/34", "12/b"} {
+		_, _, err = parseCompositeID(bad)
+		assert.Error(t, err, bad)
+	}
+}
+
+func TestParseTypedCompositeID(t *testing.T) {
+	parent, childType, child, err := parseTypedCompositeID("12/shell_jump/34")
+	assert.NoError(t, err)
+	assert.Equal(t, 12, parent)
+	assert.Equal(t, "shell_jump", childType)
+	assert.Equal(t, 34, child)
+	assert.Equal(t, "12/shell_jump/34", typedCompositeID(parent, childType, child))
+
+	for _, bad := range []string{"", "12/34", "12//34", "12/shell_jump/34/56", "a/shell_jump/34", "12/shell_jump/b"} {
+		_, _, _, err = parseTypedCompositeID(bad)
+		assert.Error(t, err, bad)
+	}
+}
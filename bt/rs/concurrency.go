@@ -0,0 +1,95 @@
+package rs
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"terraform-provider-sra/api"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// When the provider is configured with optimistic_concurrency, reads the item again before it's updated or
+// deleted and returns an error listing the differences when it no longer matches the prior state. This
+// catches changes made in the console, or by other tools, between the plan and the apply.
+//
+// The remote item goes through the Terraform model before comparing, so only the attributes this resource
+// manages are compared. An item that no longer exists isn't a conflict, Update and Delete handle that.
+func (r *apiResource[TApi, TTf]) checkUnchanged(ctx context.Context, state tfsdk.State, action string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if r.ApiClient == nil || !r.ApiClient.OptimisticConcurrency {
+		return diags
+	}
+
+	var tfState TTf
+	diags.Append(state.Get(ctx, &tfState)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	stateObj := reflect.ValueOf(&tfState).Elem()
+	tfId := stateObj.FieldByName("ID").Interface().(types.String)
+	id, _ := strconv.Atoi(tfId.ValueString())
+
+	remote, err := api.GetItem[TApi](r.ApiClient, &id)
+	if api.IsNotFound(err) {
+		return diags
+	}
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Error reading item with id [%d]", id),
+			fmt.Sprintf("The item couldn't be read to check it hasn't changed before the %s: %s", action, err.Error()),
+		)
+		return diags
+	}
+
+	var prior TApi
+	api.CopyTFtoAPI(ctx, stateObj, reflect.ValueOf(&prior).Elem())
+
+	// Starting from the state keeps the attributes the API doesn't return
+	remoteState := tfState
+	remoteStateObj := reflect.ValueOf(&remoteState).Elem()
+	api.CopyAPItoTF(ctx, reflect.ValueOf(remote).Elem(), remoteStateObj, reflect.TypeOf(remote).Elem())
+	var current TApi
+	api.CopyTFtoAPI(ctx, remoteStateObj, reflect.ValueOf(&current).Elem())
+
+	diff, err := api.DiffFields(current, prior)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Error comparing item with id [%d]", id),
+			"Unexpected error: "+err.Error(),
+		)
+		return diags
+	}
+	if len(diff) == 0 {
+		return diags
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("%s [%d] changed since it was last read", r.printableName(), id), map[string]any{
+		"fields": len(diff),
+	})
+
+	var detail strings.Builder
+	fmt.Fprintf(&detail, "The %s [%d] was changed outside of Terraform since it was last refreshed, so the %s was stopped:\n\n", r.printableName(), id, action)
+	for _, d := range diff {
+		was, now := string(d.Prior), string(d.Value)
+		if d.Prior == nil {
+			was = "(not set)"
+		}
+		if attr, attrDiags := state.Schema.AttributeAtPath(ctx, path.Root(d.Name)); !attrDiags.HasError() && attr.IsSensitive() {
+			was, now = "(sensitive value)", "(sensitive value)"
+		}
+		fmt.Fprintf(&detail, "  %s: %s → %s\n", d.Name, was, now)
+	}
+	detail.WriteString("\nRefresh and plan again to review the changes, then apply.")
+
+	diags.AddError(fmt.Sprintf("%s changed since it was last refreshed", r.printableName()), detail.String())
+	return diags
+}
@@ -0,0 +1,72 @@
+package rs
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+/*
+Items that a lot depends on, like Jumpoints and Jump Groups whose Jump Items are deleted with them, or Vault
+Accounts whose credentials may not be stored anywhere else, can have deletion_protection set. Unlike
+Terraform's prevent_destroy lifecycle setting, this can be set from variables.
+
+The generic Delete refuses to delete protected items, using the prior state, so turning the protection off
+has to be applied before the item can be destroyed or replaced. Plans that would delete a protected item
+get a warning from warnDeletionProtection, so this shows up before the apply.
+*/
+
+func deletionProtectionSchema() schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Description: "Prevents Terraform from deleting this item, including when a change requires replacing it. Set this to false and apply before destroying or replacing the item. Defaults to false",
+		Optional:    true,
+	}
+}
+
+// Adds a warning when the plan destroys or replaces a protected item. Resources that can have
+// deletion_protection call this from ModifyPlan, after the attribute plan modifiers have filled in
+// resp.RequiresReplace
+func warnDeletionProtection(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, resourceName string) {
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var protected types.Bool
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("deletion_protection"), &protected)...)
+	if resp.Diagnostics.HasError() || !protected.ValueBool() {
+		return
+	}
+
+	var id types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if req.Plan.Raw.IsNull() {
+		resp.Diagnostics.AddWarning(
+			fmt.Sprintf("%s [%s] is protected from deletion", resourceName, id.ValueString()),
+			"The plan destroys this item, but it has deletion_protection set, so deleting it will fail. Set deletion_protection to false and apply before destroying it.",
+		)
+		return
+	}
+
+	if len(resp.RequiresReplace) == 0 {
+		return
+	}
+
+	changed := make([]string, len(resp.RequiresReplace))
+	for i, p := range resp.RequiresReplace {
+		changed[i] = p.String()
+	}
+	resp.Diagnostics.AddAttributeWarning(
+		resp.RequiresReplace[0],
+		fmt.Sprintf("%s [%s] is protected from deletion", resourceName, id.ValueString()),
+		fmt.Sprintf("Changing %s replaces this item, but it has deletion_protection set, so deleting it will fail. Set deletion_protection to false and apply before replacing it.", strings.Join(changed, ", ")),
+	)
+}
@@ -0,0 +1,110 @@
+package rs
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"terraform-provider-sra/api"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeletionProtection(t *testing.T) {
+	ctx := context.Background()
+	deleted := []string{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if strings.HasSuffix(r.URL.Path, "oauth2/token") {
+			_, err := w.Write([]byte(`{"token_type":"Bearer","expires_in":3600,"access_token":"secret_access_granted"}`))
+			assert.Nil(t, err)
+			return
+		}
+		assert.Equal(t, http.MethodDelete, r.Method)
+		deleted = append(deleted, strings.TrimPrefix(r.URL.Path, "/api/config/v1/"))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	clientID := "id"
+	clientSecret := "🤐"
+	c, err := api.NewClient(ts.URL, &clientID, &clientSecret)
+	c.SetTest(t)
+	assert.Nil(t, err)
+
+	r := newJumpointResource().(*jumpointResource)
+	r.ApiClient = c
+
+	// Protected items aren't deleted
+	state, _ := testState(t, r, map[string]any{"id": "3", "name": "dc", "deletion_protection": true})
+	resp := resource.DeleteResponse{State: state}
+	r.Delete(ctx, resource.DeleteRequest{State: state}, &resp)
+	assert.True(t, resp.Diagnostics.HasError())
+	assert.Empty(t, deleted)
+
+	// Until the protection is turned off
+	for _, protected := range []any{false, types.BoolNull()} {
+		state, _ = testState(t, r, map[string]any{"id": "3", "name": "dc", "deletion_protection": protected})
+		resp = resource.DeleteResponse{State: state}
+		r.Delete(ctx, resource.DeleteRequest{State: state}, &resp)
+		assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	}
+	assert.Equal(t, []string{"jumpoint/3", "jumpoint/3"}, deleted)
+}
+
+func TestWarnDeletionProtection(t *testing.T) {
+	ctx := context.Background()
+	r := newVaultSSHAccountResource().(*vaultSSHAccountResource)
+
+	attrs := map[string]any{"id": "5", "type": "ssh", "name": "root", "deletion_protection": true}
+	state, _ := testState(t, r, attrs)
+	// The framework fills in RequiresReplace from the attribute plan modifiers before calling ModifyPlan
+	modifyPlan := func(plan tfsdk.State, requiresReplace ...path.Path) resource.ModifyPlanResponse {
+		resp := resource.ModifyPlanResponse{Plan: tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw}, RequiresReplace: requiresReplace}
+		r.ModifyPlan(ctx, resource.ModifyPlanRequest{State: state, Plan: resp.Plan}, &resp)
+		assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+		return resp
+	}
+
+	// In place updates are fine
+	attrs["name"] = "admin"
+	plan, _ := testState(t, r, attrs)
+	resp := modifyPlan(plan)
+	assert.Empty(t, resp.Diagnostics)
+
+	// Replacing or destroying the account warns
+	attrs["type"] = "ssh_ca"
+	plan, _ = testState(t, r, attrs)
+	resp = modifyPlan(plan, path.Root("type"))
+	assert.Len(t, resp.Diagnostics.Warnings(), 1)
+	assert.Contains(t, resp.Diagnostics.Warnings()[0].Detail(), "Changing type replaces this item")
+	assert.Equal(t, path.Root("type"), resp.Diagnostics.Warnings()[0].(diag.DiagnosticWithPath).Path())
+
+	// Whatever attribute forces the replacement
+	resp = modifyPlan(plan, path.Root("name"), path.Root("type"))
+	assert.Len(t, resp.Diagnostics.Warnings(), 1)
+	assert.Contains(t, resp.Diagnostics.Warnings()[0].Detail(), "Changing name, type replaces this item")
+	assert.Equal(t, path.Root("name"), resp.Diagnostics.Warnings()[0].(diag.DiagnosticWithPath).Path())
+
+	plan, _ = testState(t, r, nil)
+	resp = modifyPlan(plan)
+	assert.Len(t, resp.Diagnostics.Warnings(), 1)
+	assert.Contains(t, resp.Diagnostics.Warnings()[0].Summary(), "vault_ssh_account [5] is protected")
+
+	// Unless the account isn't protected
+	attrs["deletion_protection"] = false
+	state, _ = testState(t, r, attrs)
+	resp = modifyPlan(plan)
+	assert.Empty(t, resp.Diagnostics)
+
+	// New accounts have nothing to protect yet
+	state, _ = testState(t, r, nil)
+	resp = modifyPlan(plan)
+	assert.Empty(t, resp.Diagnostics)
+}
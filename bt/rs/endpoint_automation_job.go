@@ -0,0 +1,517 @@
+package rs
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"terraform-provider-sra/api"
+	"terraform-provider-sra/bt/models"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// These throw away variable declarations are to allow the compiler to
+// enforce compliance to these interfaces
+var (
+	_ resource.Resource                = &endpointAutomationJobResource{}
+	_ resource.ResourceWithConfigure   = &endpointAutomationJobResource{}
+	_ resource.ResourceWithImportState = &endpointAutomationJobResource{}
+
+	// How long to wait between polls of the job executions while waiting for
+	// a job to finish
+	endpointAutomationJobPollInterval = 10 * time.Second
+)
+
+func newEndpointAutomationJobResource() resource.Resource {
+	return &endpointAutomationJobResource{}
+}
+
+// Endpoint Automation Jobs are immutable once submitted and can't be deleted
+// through the API, so this resource only uses the generic implementation for
+// the boilerplate. Any change to the job definition submits a new job.
+type endpointAutomationJobResource struct {
+	apiResource[api.EndpointAutomationJob, models.EndpointAutomationJob]
+}
+
+var jobExecutionAttrTypes = map[string]attr.Type{
+	"endpoint_id":               types.Int64Type,
+	"state":                     types.StringType,
+	"started_running_timestamp": types.StringType,
+	"finished_timestamp":        types.StringType,
+	"finished_type":             types.StringType,
+	"finished_error":            types.StringType,
+	"exit_code":                 types.Int64Type,
+	"output_tail":               types.StringType,
+	"log_file_path":             types.StringType,
+}
+
+const endpointAutomationJobSchemaVersion int64 = 1
+
+func (r *endpointAutomationJobResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version: endpointAutomationJobSchemaVersion,
+		MarkdownDescription: `Submits an Endpoint Automation Job and waits for it to finish.
+
+Jobs can't be modified or deleted once they are submitted. Changing any part of the job definition submits a new job, and destroying this resource only removes it from the Terraform state.
+
+If any endpoint reports an error or a non-zero exit code, the apply fails and the resource is marked as tainted so that the next apply submits the job again.
+
+For descriptions of individual fields, please see the Configuration API documentation on your SRA Appliance`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"notes": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(""),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"start_at": schema.StringAttribute{
+				Description: "When the job should start, in RFC3339 format. Defaults to the time the job is submitted.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"tag": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(""),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"operating_system": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{"Windows", "Linux", "Mac"}...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"endpoint_ids": schema.SetAttribute{
+				Description: "The IDs of the Endpoint Automation Endpoints to run the job on",
+				ElementType: types.Int64Type,
+				Required:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"cmd": schema.StringAttribute{
+				Description: "The command that's run to start the script",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"script": schema.StringAttribute{
+				Description: "The script that's run on the endpoints",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"resource_ids": schema.SetAttribute{
+				Description: "The IDs of the Endpoint Automation Resources to include when running the job",
+				ElementType: types.Int64Type,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				Description: "Wait for the job to finish on every endpoint before completing the apply. Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"timeout_minutes": schema.Int64Attribute{
+				Description: "How long to wait for the job to finish before failing the apply. Defaults to 30 minutes.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(30),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"finished_timestamp": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"executions": schema.ListNestedAttribute{
+				Description: "The result of the job on each endpoint",
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"endpoint_id":               schema.Int64Attribute{Computed: true},
+						"state":                     schema.StringAttribute{Computed: true},
+						"started_running_timestamp": schema.StringAttribute{Computed: true},
+						"finished_timestamp":        schema.StringAttribute{Computed: true},
+						"finished_type":             schema.StringAttribute{Computed: true},
+						"finished_error":            schema.StringAttribute{Computed: true},
+						"exit_code":                 schema.Int64Attribute{Computed: true},
+						"output_tail":               schema.StringAttribute{Computed: true},
+						"log_file_path":             schema.StringAttribute{Computed: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *endpointAutomationJobResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(endpointAutomationJobSchemaVersion, nil)
+}
+
+func (r *endpointAutomationJobResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan models.EndpointAutomationJob
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	item := api.EndpointAutomationJob{
+		Name:            plan.Name.ValueString(),
+		Notes:           plan.Notes.ValueString(),
+		StartAt:         plan.StartAt.ValueString(),
+		Tag:             plan.Tag.ValueString(),
+		OperatingSystem: plan.OperatingSystem.ValueString(),
+		Payload: api.EndpointAutomationJobPayload{
+			Cmd:    plan.Cmd.ValueString(),
+			Script: plan.Script.ValueString(),
+		},
+	}
+	if plan.StartAt.IsNull() || plan.StartAt.IsUnknown() || item.StartAt == "" {
+		item.StartAt = time.Now().UTC().Format(time.RFC3339)
+	}
+
+	diags = plan.EndpointIDs.ElementsAs(ctx, &item.EndpointIDs, false)
+	resp.Diagnostics.Append(diags...)
+	if !plan.ResourceIDs.IsNull() && !plan.ResourceIDs.IsUnknown() {
+		diags = plan.ResourceIDs.ElementsAs(ctx, &item.Payload.ResourceIDs, false)
+		resp.Diagnostics.Append(diags...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "🙀 submitting endpoint automation job", map[string]interface{}{
+		"name":      item.Name,
+		"endpoints": item.EndpointIDs,
+	})
+	newItem, err := api.CreateItem(r.ApiClient, item)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Endpoint Automation Job",
+			"Unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	id := *newItem.ID
+	plan.ID = types.StringValue(strconv.Itoa(id))
+	plan.StartAt = types.StringValue(item.StartAt)
+	if newItem.StartAt != "" {
+		plan.StartAt = types.StringValue(newItem.StartAt)
+	}
+
+	var executions []api.EndpointAutomationJobExecution
+	finished := true
+	if plan.WaitForCompletion.ValueBool() {
+		timeout := time.Duration(plan.TimeoutMinutes.ValueInt64()) * time.Minute
+		executions, finished, err = r.waitForJob(ctx, id, len(item.EndpointIDs), timeout)
+	} else {
+		executions, err = r.listExecutions(id)
+	}
+
+	if err == nil {
+		var job *api.EndpointAutomationJob
+		job, err = api.GetItem[api.EndpointAutomationJob](r.ApiClient, &id)
+		if err == nil {
+			plan.FinishedTimestamp = types.StringPointerValue(job.FinishedTimestamp)
+		}
+	}
+	if plan.FinishedTimestamp.IsUnknown() {
+		plan.FinishedTimestamp = types.StringNull()
+	}
+
+	plan.Executions, diags = jobExecutionsToTF(ctx, executions)
+	resp.Diagnostics.Append(diags...)
+
+	// The job exists at this point, so the state is always saved. Any error
+	// below taints the resource so the job is submitted again on the next apply.
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setIdentityID(ctx, resp.Identity, plan.ID)...)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error waiting for Endpoint Automation Job [%d]", id),
+			"Unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if !finished {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Timed out waiting for Endpoint Automation Job [%d]", id),
+			fmt.Sprintf("The job did not finish on all endpoints within %d minutes. Increase timeout_minutes or set wait_for_completion to false to submit the job without waiting.", plan.TimeoutMinutes.ValueInt64()),
+		)
+		return
+	}
+
+	if failures := failedJobExecutions(executions); len(failures) > 0 {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Endpoint Automation Job [%d] failed", id),
+			fmt.Sprintf("The job failed on %d of %d endpoints:\n%s", len(failures), len(executions), strings.Join(failures, "\n")),
+		)
+		return
+	}
+}
+
+func (r *endpointAutomationJobResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.EndpointAutomationJob
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, _ := strconv.Atoi(state.ID.ValueString())
+	job, err := api.GetItem[api.EndpointAutomationJob](r.ApiClient, &id)
+	if api.IsNotFound(err) {
+		tflog.Warn(ctx, fmt.Sprintf("endpoint_automation_job [%d] no longer exists, removing it from state", id))
+		removeFromState(ctx, resp, setIdentityID(ctx, resp.Identity, state.ID))
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading item",
+			"Unexpected reading item ID ["+strconv.Itoa(id)+"]: "+err.Error(),
+		)
+		return
+	}
+
+	executions, err := r.listExecutions(id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading item",
+			"Unexpected reading executions of item ID ["+strconv.Itoa(id)+"]: "+err.Error(),
+		)
+		return
+	}
+
+	state.Name = types.StringValue(job.Name)
+	state.Notes = types.StringValue(job.Notes)
+	state.Tag = types.StringValue(job.Tag)
+	state.OperatingSystem = types.StringValue(job.OperatingSystem)
+	state.Cmd = types.StringValue(job.Payload.Cmd)
+	state.Script = types.StringValue(job.Payload.Script)
+	state.FinishedTimestamp = types.StringPointerValue(job.FinishedTimestamp)
+
+	// The appliance may normalize these, so only fill them in when they aren't
+	// known yet, such as after an import
+	if state.StartAt.IsNull() {
+		state.StartAt = types.StringValue(job.StartAt)
+	}
+	if state.EndpointIDs.IsNull() {
+		endpointIDs := []int{}
+		for _, e := range executions {
+			if e.EndpointID != nil {
+				endpointIDs = append(endpointIDs, *e.EndpointID)
+			}
+		}
+		state.EndpointIDs, diags = types.SetValueFrom(ctx, types.Int64Type, endpointIDs)
+		resp.Diagnostics.Append(diags...)
+	}
+	if state.ResourceIDs.IsNull() {
+		if resourceIDs := job.Payload.AttachedResourceIDs(); len(resourceIDs) > 0 {
+			state.ResourceIDs, diags = types.SetValueFrom(ctx, types.Int64Type, resourceIDs)
+			resp.Diagnostics.Append(diags...)
+		}
+	}
+	if state.WaitForCompletion.IsNull() {
+		state.WaitForCompletion = types.BoolValue(true)
+	}
+	if state.TimeoutMinutes.IsNull() {
+		state.TimeoutMinutes = types.Int64Value(30)
+	}
+
+	state.Executions, diags = jobExecutionsToTF(ctx, executions)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setIdentityID(ctx, resp.Identity, state.ID)...)
+}
+
+// Only the attributes that control waiting can change without replacement, and
+// those don't affect the submitted job
+func (r *endpointAutomationJobResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan models.EndpointAutomationJob
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setIdentityID(ctx, resp.Identity, plan.ID)...)
+}
+
+func (r *endpointAutomationJobResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Endpoint Automation Jobs can't be deleted, removing from state only")
+}
+
+func (r *endpointAutomationJobResource) listExecutions(id int) ([]api.EndpointAutomationJobExecution, error) {
+	e := api.EndpointAutomationJobExecution{JobID: &id}
+	return api.ListItemsEndpoint[api.EndpointAutomationJobExecution](r.ApiClient, e.Endpoint())
+}
+
+// Polls the executions of the job with the given ID until every endpoint has
+// finished or the timeout expires. The returned bool reports whether the job
+// finished in time.
+func (r *endpointAutomationJobResource) waitForJob(ctx context.Context, id int, endpointCount int, timeout time.Duration) ([]api.EndpointAutomationJobExecution, bool, error) {
+	deadline := time.Now().Add(timeout)
+	for {
+		executions, err := r.listExecutions(id)
+		if err != nil {
+			return nil, false, err
+		}
+
+		if jobExecutionsFinished(executions, endpointCount) {
+			return executions, true, nil
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("⏳ waiting for endpoint automation job [%d]", id), map[string]interface{}{
+			"executions": len(executions),
+		})
+
+		if time.Now().After(deadline) {
+			return executions, false, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return executions, false, ctx.Err()
+		case <-time.After(endpointAutomationJobPollInterval):
+		}
+	}
+}
+
+// A job is finished once every endpoint it was submitted to has an execution
+// with a finished timestamp
+func jobExecutionsFinished(executions []api.EndpointAutomationJobExecution, endpointCount int) bool {
+	if len(executions) == 0 || len(executions) < endpointCount {
+		return false
+	}
+
+	for _, e := range executions {
+		if e.FinishedTimestamp == nil || *e.FinishedTimestamp == "" {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Returns a description of every execution that reported an error or a non-zero
+// exit code. The API doesn't document the values of finished_type, so it isn't
+// used to decide whether an execution failed
+func failedJobExecutions(executions []api.EndpointAutomationJobExecution) []string {
+	failures := []string{}
+	for _, e := range executions {
+		endpoint := "unknown"
+		if e.EndpointID != nil {
+			endpoint = strconv.Itoa(*e.EndpointID)
+		}
+
+		if e.FinishedError != nil && *e.FinishedError != "" {
+			failures = append(failures, fmt.Sprintf("endpoint [%s]: %s", endpoint, *e.FinishedError))
+		} else if e.ExitCode != nil && *e.ExitCode != 0 {
+			failures = append(failures, fmt.Sprintf("endpoint [%s]: exited with code %d", endpoint, int64(*e.ExitCode)))
+		}
+	}
+
+	return failures
+}
+
+func jobExecutionsToTF(ctx context.Context, executions []api.EndpointAutomationJobExecution) (types.List, diag.Diagnostics) {
+	tfExecutions := []models.EndpointAutomationJobExecution{}
+	for _, e := range executions {
+		tfExec := models.EndpointAutomationJobExecution{
+			EndpointID:              types.Int64Null(),
+			State:                   types.StringValue(e.State),
+			StartedRunningTimestamp: types.StringPointerValue(e.StartedRunningTimestamp),
+			FinishedTimestamp:       types.StringPointerValue(e.FinishedTimestamp),
+			FinishedType:            types.StringPointerValue(e.FinishedType),
+			FinishedError:           types.StringPointerValue(e.FinishedError),
+			ExitCode:                types.Int64Null(),
+			OutputTail:              types.StringPointerValue(e.OutputTail),
+			LogFilePath:             types.StringPointerValue(e.LogFilePath),
+		}
+		if e.EndpointID != nil {
+			tfExec.EndpointID = types.Int64Value(int64(*e.EndpointID))
+		}
+		if e.ExitCode != nil {
+			tfExec.ExitCode = types.Int64Value(int64(*e.ExitCode))
+		}
+		tfExecutions = append(tfExecutions, tfExec)
+	}
+
+	return types.ListValueFrom(ctx, types.ObjectType{AttrTypes: jobExecutionAttrTypes}, tfExecutions)
+}
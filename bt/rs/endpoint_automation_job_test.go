@@ -0,0 +1,139 @@
+package rs
+
+import (
+	"context"
+	"testing"
+
+	"terraform-provider-sra/api"
+	"terraform-provider-sra/bt/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func jobExecution(endpointID int, finished string, exitCode float64, finishedError string) api.EndpointAutomationJobExecution {
+	e := api.EndpointAutomationJobExecution{
+		EndpointID: &endpointID,
+		ExitCode:   &exitCode,
+	}
+	if finished != "" {
+		e.FinishedTimestamp = &finished
+	}
+	if finishedError != "" {
+		e.FinishedError = &finishedError
+	}
+	return e
+}
+
+func TestJobExecutionsFinished(t *testing.T) {
+	assert.False(t, jobExecutionsFinished(nil, 0))
+	assert.False(t, jobExecutionsFinished([]api.EndpointAutomationJobExecution{
+		jobExecution(1, "2026-01-01T00:00:00Z", 0, ""),
+	}, 2))
+	assert.False(t, jobExecutionsFinished([]api.EndpointAutomationJobExecution{
+		jobExecution(1, "2026-01-01T00:00:00Z", 0, ""),
+		jobExecution(2, "", 0, ""),
+	}, 2))
+	assert.True(t, jobExecutionsFinished([]api.EndpointAutomationJobExecution{
+		jobExecution(1, "2026-01-01T00:00:00Z", 0, ""),
+		jobExecution(2, "2026-01-01T00:00:00Z", 1, ""),
+	}, 2))
+}
+
+func TestFailedJobExecutions(t *testing.T) {
+	failures := failedJobExecutions([]api.EndpointAutomationJobExecution{
+		jobExecution(1, "2026-01-01T00:00:00Z", 0, ""),
+		jobExecution(2, "2026-01-01T00:00:00Z", 3, ""),
+		jobExecution(3, "2026-01-01T00:00:00Z", 0, "endpoint went offline"),
+	})
+
+	assert.Equal(t, []string{
+		"endpoint [2]: exited with code 3",
+		"endpoint [3]: endpoint went offline",
+	}, failures)
+	assert.Empty(t, failedJobExecutions([]api.EndpointAutomationJobExecution{
+		jobExecution(1, "2026-01-01T00:00:00Z", 0, ""),
+	}))
+}
+
+func TestFailedJobExecutionsFinishType(t *testing.T) {
+	executions := []api.EndpointAutomationJobExecution{}
+	for i, finishType := range []string{"exited", "cancelled"} {
+		e := jobExecution(i+1, "2026-01-01T00:00:00Z", 0, "")
+		e.FinishedType = &finishType
+		executions = append(executions, e)
+	}
+	cancelled := "cancelled"
+	e := jobExecution(3, "2026-01-01T00:00:00Z", 0, "cancelled by an administrator")
+	e.FinishedType = &cancelled
+	executions = append(executions, e)
+
+	// finished_type has no documented values, so only the error and exit code decide a failure
+	assert.Equal(t, []string{
+		"endpoint [3]: cancelled by an administrator",
+	}, failedJobExecutions(executions))
+}
+
+func TestJobExecutionsToTF(t *testing.T) {
+	list, diags := jobExecutionsToTF(context.Background(), []api.EndpointAutomationJobExecution{
+		jobExecution(4, "2026-01-01T00:00:00Z", 2, ""),
+	})
+	assert.False(t, diags.HasError())
+	assert.Len(t, list.Elements(), 1)
+}
+
+func TestUpdateEndpointAutomationJobTimeout(t *testing.T) {
+	ctx := context.Background()
+	r := newEndpointAutomationJobResource().(*endpointAutomationJobResource)
+
+	attrs := map[string]any{
+		"id":                  "3",
+		"name":                "patch",
+		"notes":               "",
+		"start_at":            "2026-01-01T00:00:00Z",
+		"tag":                 "",
+		"operating_system":    "Linux",
+		"cmd":                 "bash",
+		"script":              "echo hi",
+		"wait_for_completion": true,
+		"timeout_minutes":     int64(30),
+		"finished_timestamp":  "2026-01-01T00:05:00Z",
+	}
+	state, identity := testState(t, r, attrs)
+	attrs["timeout_minutes"] = int64(60)
+	attrs["finished_timestamp"] = types.StringUnknown()
+	plan, _ := testState(t, r, attrs)
+
+	// Plan the computed attribute the way Terraform does before calling Update
+	var finished types.String
+	assert.False(t, plan.GetAttribute(ctx, path.Root("finished_timestamp"), &finished).HasError())
+	var stateFinished types.String
+	assert.False(t, state.GetAttribute(ctx, path.Root("finished_timestamp"), &stateFinished).HasError())
+	modifierReq := planmodifier.StringRequest{
+		Path:        path.Root("finished_timestamp"),
+		State:       state,
+		Plan:        tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw},
+		StateValue:  stateFinished,
+		PlanValue:   finished,
+		ConfigValue: types.StringNull(),
+	}
+	modifierResp := planmodifier.StringResponse{PlanValue: finished}
+	for _, m := range plan.Schema.GetAttributes()["finished_timestamp"].(schema.StringAttribute).PlanModifiers {
+		m.PlanModifyString(ctx, modifierReq, &modifierResp)
+	}
+	assert.False(t, plan.SetAttribute(ctx, path.Root("finished_timestamp"), modifierResp.PlanValue).HasError())
+
+	resp := resource.UpdateResponse{State: state, Identity: identity}
+	r.Update(ctx, resource.UpdateRequest{Plan: tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw}, State: state}, &resp)
+	assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var tf models.EndpointAutomationJob
+	assert.False(t, resp.State.Get(ctx, &tf).HasError())
+	assert.Equal(t, int64(60), tf.TimeoutMinutes.ValueInt64())
+	assert.Equal(t, "2026-01-01T00:05:00Z", tf.FinishedTimestamp.ValueString())
+}
@@ -0,0 +1,341 @@
+package rs
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"terraform-provider-sra/api"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+/*
+The group policy association resources manage a single group policy membership of a Jump Group, Jumpoint,
+Vault Account or Vault Account Group. They are the standalone alternative to the inline group_policy_memberships
+of those resources, so the memberships can be owned by a different configuration than the object itself.
+
+The resource type names come from the API models, so api.GroupPolicyJumpGroup is exposed as
+sra_group_policy_jump_group. The Terraform model must have the same fields as the API model, plus the ID, and
+the member attribute is named after the JSON name of the member ID in the API model.
+*/
+type groupPolicyAssociationResource[TApi api.APIResource, PT api.GroupPolicyMembershipPtr[TApi], TTf any] struct {
+	apiResource[TApi, TTf]
+}
+
+const groupPolicyAssociationSchemaVersion int64 = 1
+
+func groupPolicyAssociationSchema(description string, memberAttr string, attributes map[string]schema.Attribute) schema.Schema {
+	attributes["id"] = schema.StringAttribute{
+		Description: fmt.Sprintf("The ID of the membership in the form <group_policy_id>/<%s>", memberAttr),
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+	attributes["group_policy_id"] = schema.StringAttribute{
+		Description: "The ID of the Group Policy",
+		Required:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+	attributes[memberAttr] = schema.Int64Attribute{
+		Required: true,
+		PlanModifiers: []planmodifier.Int64{
+			int64planmodifier.RequiresReplace(),
+		},
+	}
+
+	return schema.Schema{
+		Version: groupPolicyAssociationSchemaVersion,
+		MarkdownDescription: description + `
+
+Don't also manage the memberships of the same object with its inline group_policy_memberships attribute, or each will keep reverting the changes of the other.
+
+For descriptions of individual fields, please see the Configuration API documentation on your SRA Appliance`,
+		Attributes: attributes,
+	}
+}
+
+func (r *groupPolicyAssociationResource[TApi, PT, TTf]) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(groupPolicyAssociationSchemaVersion, nil)
+}
+
+// Returns the name of the member ID attribute, such as jump_group_id
+func (r *groupPolicyAssociationResource[TApi, PT, TTf]) memberAttribute() string {
+	apiType := reflect.TypeOf((*TApi)(nil)).Elem()
+	for i := 0; i < apiType.NumField(); i++ {
+		field := apiType.Field(i)
+		if field.Tag.Get("tfsdk") == "-" {
+			return strings.Split(field.Tag.Get("json"), ",")[0]
+		}
+	}
+	panic(fmt.Sprintf("%s has no member ID", apiType.Name()))
+}
+
+func (r *groupPolicyAssociationResource[TApi, PT, TTf]) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = compositeIdentitySchema("group_policy_id", r.memberAttribute())
+}
+
+func (r *groupPolicyAssociationResource[TApi, PT, TTf]) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var memberID types.Int64
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root(r.memberAttribute()), &memberID)...)
+	if resp.Diagnostics.HasError() || memberID.IsNull() || memberID.IsUnknown() {
+		return
+	}
+
+	if trackMembershipOwner(r.memberAttribute(), int(memberID.ValueInt64()), false) {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root(r.memberAttribute()),
+			"Group policy memberships are also managed inline",
+			membershipConflictDetail(r.printableName(), r.memberAttribute(), int(memberID.ValueInt64())),
+		)
+	}
+}
+
+// Builds the API model from the Terraform model
+func (r *groupPolicyAssociationResource[TApi, PT, TTf]) toAPI(ctx context.Context, tf *TTf) (TApi, int) {
+	var item TApi
+	api.CopyTFtoAPI(ctx, reflect.ValueOf(tf).Elem(), reflect.ValueOf(&item).Elem())
+
+	// The member ID isn't part of the API model's Terraform fields, so it's copied separately
+	memberID := 0
+	tfObj := reflect.ValueOf(tf).Elem()
+	for i := 0; i < tfObj.NumField(); i++ {
+		if tfObj.Type().Field(i).Tag.Get("tfsdk") == r.memberAttribute() {
+			memberID = int(tfObj.Field(i).Interface().(types.Int64).ValueInt64())
+		}
+	}
+	PT(&item).SetMembership(PT(&item).GroupPolicy(), memberID)
+	return item, memberID
+}
+
+// Copies the API model to the Terraform model, and sets its ID
+func (r *groupPolicyAssociationResource[TApi, PT, TTf]) fromAPI(ctx context.Context, item TApi, tf *TTf) (types.String, error) {
+	gpID, err := strconv.Atoi(PT(&item).GroupPolicy())
+	if err != nil {
+		return types.StringNull(), fmt.Errorf("invalid group policy ID [%s]", PT(&item).GroupPolicy())
+	}
+	id := types.StringValue(compositeID(gpID, PT(&item).MemberID()))
+
+	tfObj := reflect.ValueOf(tf).Elem()
+	api.CopyAPItoTF(ctx, reflect.ValueOf(&item).Elem(), tfObj, reflect.TypeOf(item))
+	*(*types.String)(tfObj.FieldByName("ID").Addr().UnsafePointer()) = id
+	return id, nil
+}
+
+func (r *groupPolicyAssociationResource[TApi, PT, TTf]) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan TTf
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	item, memberID := r.toAPI(ctx, &plan)
+	results, err := applyGroupPolicyMemberships[TApi, PT](ctx, r.ApiClient, memberID, []TApi{item}, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error adding group policy membership",
+			fmt.Sprintf("Unexpected error adding %s [%d] to group policy [%s]: %s", r.memberAttribute(), memberID, PT(&item).GroupPolicy(), err.Error()),
+		)
+		return
+	}
+
+	r.setState(ctx, results[0], &plan, &resp.State, resp.Identity, &resp.Diagnostics)
+}
+
+func (r *groupPolicyAssociationResource[TApi, PT, TTf]) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state TTf
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := reflect.ValueOf(state).FieldByName("ID").Interface().(types.String)
+	gpID, memberID, err := parseCompositeID(id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading group policy membership", err.Error())
+		return
+	}
+
+	var item TApi
+	PT(&item).SetMembership(strconv.Itoa(gpID), memberID)
+	results, err := refreshGroupPolicyMemberships[TApi, PT](ctx, r.ApiClient, memberID, []TApi{item})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading group policy membership",
+			fmt.Sprintf("Unexpected error reading %s [%d] of group policy [%d]: %s", r.memberAttribute(), memberID, gpID, err.Error()),
+		)
+		return
+	}
+	if len(results) == 0 {
+		removeFromState(ctx, resp, setCompositeIdentity(ctx, resp.Identity, id, "group_policy_id", r.memberAttribute()))
+		return
+	}
+
+	r.setState(ctx, results[0], &state, &resp.State, resp.Identity, &resp.Diagnostics)
+}
+
+func (r *groupPolicyAssociationResource[TApi, PT, TTf]) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state TTf
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	item, memberID := r.toAPI(ctx, &plan)
+	current, _ := r.toAPI(ctx, &state)
+	results, err := applyGroupPolicyMemberships[TApi, PT](ctx, r.ApiClient, memberID, []TApi{item}, []TApi{current})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating group policy membership",
+			fmt.Sprintf("Unexpected error updating %s [%d] of group policy [%s]: %s", r.memberAttribute(), memberID, PT(&item).GroupPolicy(), err.Error()),
+		)
+		return
+	}
+
+	r.setState(ctx, results[0], &plan, &resp.State, resp.Identity, &resp.Diagnostics)
+}
+
+func (r *groupPolicyAssociationResource[TApi, PT, TTf]) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state TTf
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	item, memberID := r.toAPI(ctx, &state)
+	_, err := applyGroupPolicyMemberships[TApi, PT](ctx, r.ApiClient, memberID, nil, []TApi{item})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error removing group policy membership",
+			fmt.Sprintf("Unexpected error removing %s [%d] from group policy [%s]: %s", r.memberAttribute(), memberID, PT(&item).GroupPolicy(), err.Error()),
+		)
+		return
+	}
+}
+
+// Imports using an ID in the form <group_policy_id>/<member_id>, or an identity with both IDs
+func (r *groupPolicyAssociationResource[TApi, PT, TTf]) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importID := req.ID
+	if importID == "" && req.Identity != nil {
+		var diags diag.Diagnostics
+		importID, diags = compositeIDFromIdentity(ctx, req.Identity, "group_policy_id", r.memberAttribute())
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	gpID, memberID, err := parseCompositeID(importID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), compositeID(gpID, memberID))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group_policy_id"), strconv.Itoa(gpID))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(r.memberAttribute()), int64(memberID))...)
+}
+
+func (r *groupPolicyAssociationResource[TApi, PT, TTf]) setState(ctx context.Context, item TApi, tf *TTf, state *tfsdk.State, identity *tfsdk.ResourceIdentity, diags *diag.Diagnostics) {
+	id, err := r.fromAPI(ctx, item, tf)
+	if err != nil {
+		diags.AddError("Error reading group policy membership", err.Error())
+		return
+	}
+
+	diags.Append(state.Set(ctx, tf)...)
+	if diags.HasError() {
+		return
+	}
+
+	diags.Append(setCompositeIdentity(ctx, identity, id, "group_policy_id", r.memberAttribute())...)
+}
+
+/*
+Objects in the same configuration that have their memberships managed both inline and through the standalone
+resources are tracked here, so the plan can warn about it. The provider only sees the configuration it is
+running for, so memberships managed from other configurations can't be detected.
+*/
+var membershipOwners = struct {
+	sync.Mutex
+	inline     map[string]bool
+	standalone map[string]bool
+}{
+	inline:     map[string]bool{},
+	standalone: map[string]bool{},
+}
+
+// Records that the memberships of the object are managed inline or through a standalone resource, returning
+// whether they are also managed the other way
+func trackMembershipOwner(memberAttr string, memberID int, inline bool) bool {
+	key := fmt.Sprintf("%s/%d", memberAttr, memberID)
+
+	membershipOwners.Lock()
+	defer membershipOwners.Unlock()
+
+	if inline {
+		membershipOwners.inline[key] = true
+		return membershipOwners.standalone[key]
+	}
+	membershipOwners.standalone[key] = true
+	return membershipOwners.inline[key]
+}
+
+func membershipConflictDetail(resourceName string, memberAttr string, memberID int) string {
+	return fmt.Sprintf(
+		"The group policy memberships of %s [%d] are managed both with sra_%s and with the inline group_policy_memberships attribute. "+
+			"Manage them in only one way, or each will keep reverting the changes of the other.",
+		memberAttr, memberID, resourceName,
+	)
+}
+
+// Warns when the inline group_policy_memberships of the planned object are also managed through a standalone
+// group policy association resource
+func checkInlineMemberships(ctx context.Context, plan tfsdk.Plan, memberAttr string, resourceName string, diags *diag.Diagnostics) {
+	if plan.Raw.IsNull() {
+		return
+	}
+
+	var id types.String
+	var tfGPList types.Set
+	diags.Append(plan.GetAttribute(ctx, path.Root("id"), &id)...)
+	diags.Append(plan.GetAttribute(ctx, path.Root("group_policy_memberships"), &tfGPList)...)
+	if diags.HasError() || id.IsUnknown() || id.IsNull() || tfGPList.IsNull() {
+		return
+	}
+
+	memberID, err := strconv.Atoi(id.ValueString())
+	if err != nil {
+		return
+	}
+
+	if trackMembershipOwner(memberAttr, memberID, true) {
+		tflog.Warn(ctx, "🌈 group policy memberships are managed twice", map[string]interface{}{
+			"member": memberID,
+		})
+		diags.AddAttributeWarning(
+			path.Root("group_policy_memberships"),
+			"Group policy memberships are also managed by a standalone resource",
+			membershipConflictDetail(resourceName, memberAttr, memberID),
+		)
+	}
+}
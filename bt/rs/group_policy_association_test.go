@@ -0,0 +1,199 @@
+package rs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"terraform-provider-sra/api"
+	"terraform-provider-sra/bt/models"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroupPolicyJumpGroupAssociation(t *testing.T) {
+	ctx := context.Background()
+	var mutex sync.Mutex
+	members := map[string]string{}
+	requests := []string{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if strings.HasSuffix(r.URL.Path, "oauth2/token") {
+			_, err := w.Write([]byte(`{"token_type":"Bearer","expires_in":3600,"access_token":"secret_access_granted"}`))
+			assert.Nil(t, err)
+			return
+		}
+		mutex.Lock()
+		defer mutex.Unlock()
+		endpoint := strings.TrimPrefix(r.URL.Path, "/api/config/v1/")
+		requests = append(requests, r.Method+" "+endpoint)
+		body, err := io.ReadAll(r.Body)
+		assert.Nil(t, err)
+
+		switch r.Method {
+		case http.MethodPost:
+			if strings.HasSuffix(endpoint, "provision") {
+				_, err = w.Write([]byte(`{}`))
+				assert.Nil(t, err)
+				return
+			}
+			var m api.GroupPolicyJumpGroup
+			assert.Nil(t, json.Unmarshal(body, &m))
+			members[fmt.Sprintf("%s/%d", endpoint, *m.JumpGroupID)] = string(body)
+		case http.MethodPatch:
+			members[endpoint] = string(body)
+		case http.MethodDelete:
+			delete(members, endpoint)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		if existing, ok := members[endpoint]; ok {
+			body = []byte(existing)
+		} else if r.Method == http.MethodGet {
+			w.WriteHeader(http.StatusNotFound)
+			body = []byte(`{"message":"Not Found"}`)
+		}
+		_, err = w.Write(body)
+		assert.Nil(t, err)
+	}))
+	defer ts.Close()
+
+	clientID := "id"
+	clientSecret := "🤐"
+	c, err := api.NewClient(ts.URL, &clientID, &clientSecret)
+	c.SetTest(t)
+	assert.Nil(t, err)
+
+	r := newGroupPolicyJumpGroupResource().(*groupPolicyJumpGroupResource)
+	r.ApiClient = c
+
+	getModel := func(state tfsdk.State) models.GroupPolicyJumpGroupAssociation {
+		var tf models.GroupPolicyJumpGroupAssociation
+		assert.False(t, state.Get(ctx, &tf).HasError())
+		return tf
+	}
+
+	// Create adds the membership and provisions the group policy
+	plan, _ := testState(t, r, map[string]any{
+		"group_policy_id":   "2",
+		"jump_group_id":     int64(5),
+		"jump_item_role_id": int64(3),
+		"jump_policy_id":    int64(0),
+	})
+	state, identity := testState(t, r, nil)
+	createResp := resource.CreateResponse{State: state, Identity: identity}
+	r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw}}, &createResp)
+	assert.False(t, createResp.Diagnostics.HasError(), createResp.Diagnostics)
+	assert.Equal(t, []string{"POST group-policy/2/jump-group", "POST group-policy/2/provision"}, requests)
+	created := getModel(createResp.State)
+	assert.Equal(t, "2/5", created.ID.ValueString())
+	assert.Equal(t, int64(3), created.JumpItemRoleID.ValueInt64())
+
+	var identityID types.String
+	createResp.Identity.GetAttribute(ctx, path.Root("id"), &identityID)
+	assert.Equal(t, "2/5", identityID.ValueString())
+
+	// Import only needs the composite ID, and Read fills in the rest
+	state, identity = testState(t, r, nil)
+	importResp := resource.ImportStateResponse{State: state, Identity: identity}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: "2/5"}, &importResp)
+	assert.False(t, importResp.Diagnostics.HasError(), importResp.Diagnostics)
+
+	readResp := resource.ReadResponse{State: importResp.State, Identity: importResp.Identity}
+	r.Read(ctx, resource.ReadRequest{State: importResp.State}, &readResp)
+	assert.False(t, readResp.Diagnostics.HasError(), readResp.Diagnostics)
+	assert.Equal(t, created, getModel(readResp.State))
+
+	// Changing the role updates the membership in place
+	requests = nil
+	plan, _ = testState(t, r, map[string]any{
+		"id":                "2/5",
+		"group_policy_id":   "2",
+		"jump_group_id":     int64(5),
+		"jump_item_role_id": int64(4),
+		"jump_policy_id":    int64(0),
+	})
+	updateResp := resource.UpdateResponse{State: createResp.State, Identity: createResp.Identity}
+	r.Update(ctx, resource.UpdateRequest{Plan: tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw}, State: createResp.State}, &updateResp)
+	assert.False(t, updateResp.Diagnostics.HasError(), updateResp.Diagnostics)
+	assert.Equal(t, []string{"PATCH group-policy/2/jump-group/5", "POST group-policy/2/provision"}, requests)
+	assert.Equal(t, int64(4), getModel(updateResp.State).JumpItemRoleID.ValueInt64())
+
+	// Delete removes it again
+	requests = nil
+	deleteResp := resource.DeleteResponse{State: updateResp.State}
+	r.Delete(ctx, resource.DeleteRequest{State: updateResp.State}, &deleteResp)
+	assert.False(t, deleteResp.Diagnostics.HasError(), deleteResp.Diagnostics)
+	assert.Equal(t, []string{"DELETE group-policy/2/jump-group/5", "POST group-policy/2/provision"}, requests)
+
+	// So it's dropped from state the next time it's read
+	readResp = resource.ReadResponse{State: updateResp.State, Identity: updateResp.Identity}
+	r.Read(ctx, resource.ReadRequest{State: updateResp.State}, &readResp)
+	assert.False(t, readResp.Diagnostics.HasError(), readResp.Diagnostics)
+	assert.True(t, readResp.State.Raw.IsNull())
+}
+
+func TestGroupPolicyAssociationImportID(t *testing.T) {
+	ctx := context.Background()
+	r := newGroupPolicyVaultAccountResource().(*groupPolicyVaultAccountResource)
+
+	state, identity := testState(t, r, nil)
+	resp := resource.ImportStateResponse{State: state, Identity: identity}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: "3/21"}, &resp)
+	assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var tf models.GroupPolicyVaultAccountAssociation
+	assert.False(t, resp.State.Get(ctx, &tf).HasError())
+	assert.Equal(t, "3/21", tf.ID.ValueString())
+	assert.Equal(t, "3", tf.GroupPolicyID.ValueString())
+	assert.Equal(t, int64(21), tf.AccountID.ValueInt64())
+
+	state, identity = testState(t, r, nil)
+	resp = resource.ImportStateResponse{State: state, Identity: identity}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: "21"}, &resp)
+	assert.True(t, resp.Diagnostics.HasError())
+}
+
+func TestGroupPolicyMembershipConflict(t *testing.T) {
+	ctx := context.Background()
+	inline := newJumpointResource().(*jumpointResource)
+	standalone := newGroupPolicyJumpointResource().(*groupPolicyJumpointResource)
+
+	modifyPlan := func(r resource.ResourceWithModifyPlan, attrs map[string]any) resource.ModifyPlanResponse {
+		state, _ := testState(t, r.(resource.ResourceWithIdentity), attrs)
+		plan := tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}
+		resp := resource.ModifyPlanResponse{Plan: plan}
+		r.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: plan}, &resp)
+		assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+		return resp
+	}
+
+	// Jumpoints without inline memberships don't conflict
+	modifyPlan(inline, map[string]any{"id": "901"})
+	resp := modifyPlan(standalone, map[string]any{"group_policy_id": "1", "jumpoint_id": int64(901)})
+	assert.Equal(t, 0, resp.Diagnostics.WarningsCount())
+
+	// But managing them both ways does, whichever is planned first
+	resp = modifyPlan(inline, map[string]any{
+		"id":                       "901",
+		"group_policy_memberships": []api.GroupPolicyJumpoint{{GroupPolicyID: new(string)}},
+	})
+	assert.Equal(t, 1, resp.Diagnostics.WarningsCount())
+	assert.Contains(t, resp.Diagnostics.Warnings()[0].Detail(), "sra_group_policy_jumpoint")
+
+	modifyPlan(inline, map[string]any{
+		"id":                       "902",
+		"group_policy_memberships": []api.GroupPolicyJumpoint{{GroupPolicyID: new(string)}},
+	})
+	resp = modifyPlan(standalone, map[string]any{"group_policy_id": "1", "jumpoint_id": int64(902)})
+	assert.Equal(t, 1, resp.Diagnostics.WarningsCount())
+}
@@ -0,0 +1,188 @@
+package rs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"terraform-provider-sra/api"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Because of the way the PHP code handles changing memberships, the memberships
+// of a group policy can't be changed in parallel, and the group policy has to be
+// provisioned afterwards for the changes to apply. Each group policy gets its own
+// lock, so unrelated policies can still be changed at the same time.
+//
+// Resources that change the same group policy at the same time share a batch.
+// The policy is provisioned once, when the last resource in the batch is done
+// with its changes, instead of once per resource.
+type groupPolicyBatch struct {
+	members int
+	done    chan struct{}
+	err     error
+}
+
+// Finding every membership of an item, for the authoritative group_policy_membership_mode, means listing
+// the members of every group policy. The group policies, and the members of each, are cached per API client,
+// so a refresh lists them once instead of once per resource. The client is configured for each run of the
+// provider, so that's as long as the cache lasts, and changing the memberships of a group policy drops its
+// cached members.
+type groupPolicyMemberCache struct {
+	policies []api.GroupPolicy
+	// The members of each group policy, by group policy ID and then by endpoint
+	members map[string]map[string]any
+}
+
+var (
+	groupPolicyMutex        sync.Mutex
+	groupPolicyLocks        = map[string]*sync.Mutex{}
+	groupPolicyBatches      = map[string]*groupPolicyBatch{}
+	groupPolicyMemberCaches = map[*api.APIClient]*groupPolicyMemberCache{}
+)
+
+// Locks the group policy with the given ID, returning the function to unlock it
+func lockGroupPolicy(gpID string) func() {
+	groupPolicyMutex.Lock()
+	lock, ok := groupPolicyLocks[gpID]
+	if !ok {
+		lock = &sync.Mutex{}
+		groupPolicyLocks[gpID] = lock
+	}
+	groupPolicyMutex.Unlock()
+
+	lock.Lock()
+	return lock.Unlock
+}
+
+// Joins the current batch of each of the group policies, which must be left
+// with leaveGroupPolicyBatches once the memberships have been changed
+func joinGroupPolicyBatches(gpIDs []string) map[string]*groupPolicyBatch {
+	groupPolicyMutex.Lock()
+	defer groupPolicyMutex.Unlock()
+
+	batches := map[string]*groupPolicyBatch{}
+	for _, gpID := range gpIDs {
+		if _, ok := batches[gpID]; ok {
+			continue
+		}
+		batch, ok := groupPolicyBatches[gpID]
+		if !ok {
+			batch = &groupPolicyBatch{done: make(chan struct{})}
+			groupPolicyBatches[gpID] = batch
+		}
+		batch.members++
+		batches[gpID] = batch
+	}
+	return batches
+}
+
+// Leaves the batches, provisioning the group policies this was the last member
+// for, and waits until all of them have been provisioned
+func leaveGroupPolicyBatches(ctx context.Context, c *api.APIClient, batches map[string]*groupPolicyBatch) error {
+	toProvision := map[string]*groupPolicyBatch{}
+	groupPolicyMutex.Lock()
+	for gpID, batch := range batches {
+		batch.members--
+		if batch.members == 0 {
+			// Later changes start a new batch
+			delete(groupPolicyBatches, gpID)
+			toProvision[gpID] = batch
+		}
+	}
+	groupPolicyMutex.Unlock()
+
+	for gpID, batch := range toProvision {
+		tflog.Trace(ctx, "🌈 Provisioning group policy", map[string]interface{}{
+			"gp": gpID,
+		})
+		batch.err = provisionGroupPolicy(c, gpID)
+		close(batch.done)
+	}
+
+	var errs []error
+	for gpID, batch := range batches {
+		<-batch.done
+		if batch.err != nil {
+			errs = append(errs, fmt.Errorf("provisioning group policy [%s]: %w", gpID, batch.err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func provisionGroupPolicy(c *api.APIClient, gpID string) error {
+	defer lockGroupPolicy(gpID)()
+
+	p := api.GroupPolicyProvision{
+		GroupPolicyID: &gpID,
+	}
+	_, err := api.CreateItem(c, p)
+	return err
+}
+
+// Must be called with groupPolicyMutex held
+func groupPolicyCache(c *api.APIClient) *groupPolicyMemberCache {
+	cache, ok := groupPolicyMemberCaches[c]
+	if !ok {
+		cache = &groupPolicyMemberCache{members: map[string]map[string]any{}}
+		groupPolicyMemberCaches[c] = cache
+	}
+	return cache
+}
+
+// Lists the group policies, once per API client
+func cachedGroupPolicies(c *api.APIClient) ([]api.GroupPolicy, error) {
+	groupPolicyMutex.Lock()
+	policies := groupPolicyCache(c).policies
+	groupPolicyMutex.Unlock()
+	if policies != nil {
+		return policies, nil
+	}
+
+	policies, err := api.ListItems[api.GroupPolicy](c)
+	if err != nil {
+		return nil, err
+	}
+
+	groupPolicyMutex.Lock()
+	groupPolicyCache(c).policies = policies
+	groupPolicyMutex.Unlock()
+	return policies, nil
+}
+
+// Lists the members of a group policy from the given endpoint, once per API client until they change
+func cachedGroupPolicyMembers[T api.APIResource](c *api.APIClient, gpID string, endpoint string) ([]T, error) {
+	groupPolicyMutex.Lock()
+	members, ok := groupPolicyCache(c).members[gpID][endpoint].([]T)
+	groupPolicyMutex.Unlock()
+	if ok {
+		return slices.Clone(members), nil
+	}
+
+	members, err := api.ListItemsEndpoint[T](c, endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	groupPolicyMutex.Lock()
+	cache := groupPolicyCache(c)
+	if cache.members[gpID] == nil {
+		cache.members[gpID] = map[string]any{}
+	}
+	cache.members[gpID][endpoint] = members
+	groupPolicyMutex.Unlock()
+	return slices.Clone(members), nil
+}
+
+// Drops the cached members of the group policies, after their memberships were changed
+func forgetGroupPolicyMembers(c *api.APIClient, gpIDs []string) {
+	groupPolicyMutex.Lock()
+	defer groupPolicyMutex.Unlock()
+
+	cache := groupPolicyCache(c)
+	for _, gpID := range gpIDs {
+		delete(cache.members, gpID)
+	}
+}
@@ -0,0 +1,124 @@
+package rs
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"terraform-provider-sra/api"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Returns a client for a fake appliance that records the membership requests and
+// provisioning of each group policy, and checks that none are made in parallel
+func testGroupPolicyClient(t *testing.T) (*api.APIClient, func() map[string][]string) {
+	var mutex sync.Mutex
+	requests := map[string][]string{}
+	busy := map[string]bool{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if strings.HasSuffix(r.URL.Path, "oauth2/token") {
+			_, err := w.Write([]byte(`{"token_type":"Bearer","expires_in":3600,"access_token":"secret_access_granted"}`))
+			assert.Nil(t, err)
+			return
+		}
+		parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/api/config/v1/"), "/")
+		gpID := parts[1]
+		request := r.Method + " " + strings.Join(parts[2:], "/")
+
+		mutex.Lock()
+		assert.False(t, busy[gpID], "Parallel request for group policy %s", gpID)
+		busy[gpID] = true
+		requests[gpID] = append(requests[gpID], request)
+		mutex.Unlock()
+
+		time.Sleep(time.Millisecond)
+
+		mutex.Lock()
+		busy[gpID] = false
+		mutex.Unlock()
+
+		_, err := w.Write([]byte(`{}`))
+		assert.Nil(t, err)
+	}))
+	t.Cleanup(ts.Close)
+
+	clientID := "id"
+	clientSecret := "🤐"
+	c, err := api.NewClient(ts.URL, &clientID, &clientSecret)
+	assert.Nil(t, err)
+
+	return c, func() map[string][]string {
+		mutex.Lock()
+		defer mutex.Unlock()
+		return requests
+	}
+}
+
+func TestGroupPolicyBatches(t *testing.T) {
+	ctx := context.Background()
+	c, requests := testGroupPolicyClient(t)
+
+	first := joinGroupPolicyBatches([]string{"101", "102", "101"})
+	second := joinGroupPolicyBatches([]string{"101"})
+
+	// The first resource is the last one changing 102, but has to wait for 101
+	done := make(chan error)
+	go func() {
+		done <- leaveGroupPolicyBatches(ctx, c, first)
+	}()
+	select {
+	case <-done:
+		assert.Fail(t, "Left the batch before it was provisioned")
+	case <-time.After(50 * time.Millisecond):
+	}
+	assert.Equal(t, []string{"POST provision"}, requests()["102"])
+	assert.Empty(t, requests()["101"])
+
+	assert.Nil(t, leaveGroupPolicyBatches(ctx, c, second))
+	assert.Nil(t, <-done)
+	assert.Equal(t, []string{"POST provision"}, requests()["101"])
+
+	// Later changes are provisioned again
+	assert.Nil(t, leaveGroupPolicyBatches(ctx, c, joinGroupPolicyBatches([]string{"101"})))
+	assert.Equal(t, []string{"POST provision", "POST provision"}, requests()["101"])
+}
+
+func TestParallelGroupPolicyMemberships(t *testing.T) {
+	ctx := context.Background()
+	c, requests := testGroupPolicyClient(t)
+
+	membership := func(gp string) api.GroupPolicyJumpoint {
+		return api.GroupPolicyJumpoint{GroupPolicyID: &gp}
+	}
+
+	var wg sync.WaitGroup
+	for id := 1; id <= 20; id++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := applyGroupPolicyMemberships[api.GroupPolicyJumpoint](ctx, c, id,
+				[]api.GroupPolicyJumpoint{membership("201"), membership(fmt.Sprintf("%d", 210+id%2))}, nil)
+			assert.Nil(t, err)
+		}()
+	}
+	wg.Wait()
+
+	for _, gpID := range []string{"201", "210", "211"} {
+		gpRequests := requests()[gpID]
+		added := 0
+		for _, r := range gpRequests {
+			if r == "POST jumpoint" {
+				added++
+			}
+		}
+		// Every change is followed by provisioning, which isn't repeated for each resource
+		assert.Equal(t, "POST provision", gpRequests[len(gpRequests)-1])
+		assert.Less(t, len(gpRequests)-added, added, gpID)
+	}
+}
@@ -0,0 +1,71 @@
+package rs
+
+import (
+	"context"
+	"terraform-provider-sra/api"
+	"terraform-provider-sra/bt/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// These throw away variable declarations are to allow the compiler to
+// enforce compliance to these interfaces
+var (
+	_ resource.Resource                = &groupPolicyJumpGroupResource{}
+	_ resource.ResourceWithConfigure   = &groupPolicyJumpGroupResource{}
+	_ resource.ResourceWithImportState = &groupPolicyJumpGroupResource{}
+	_ resource.ResourceWithIdentity    = &groupPolicyJumpGroupResource{}
+	_ resource.ResourceWithModifyPlan  = &groupPolicyJumpGroupResource{}
+)
+
+func newGroupPolicyJumpGroupResource() resource.Resource {
+	return &groupPolicyJumpGroupResource{}
+}
+
+type groupPolicyJumpGroupResource struct {
+	groupPolicyAssociationResource[api.GroupPolicyJumpGroup, *api.GroupPolicyJumpGroup, models.GroupPolicyJumpGroupAssociation]
+}
+
+func (r *groupPolicyJumpGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = groupPolicyAssociationSchema("Manages the membership of a Jump Group in a Group Policy.", "jump_group_id", map[string]schema.Attribute{
+		"jump_item_role_id": schema.Int64Attribute{
+			Description: `The ID of the Jump Item Role that applies to this membership. Omitting or 0 means "User's Default"`,
+			Optional:    true,
+			Computed:    true,
+			Default:     int64default.StaticInt64(0),
+		},
+		"jump_policy_id": schema.Int64Attribute{
+			Description: `The ID of the Jump Policy that applies to this membership. Omitting or 0 means "Set on Jump Items"
+
+This field only applies to PRA`,
+			Optional: true,
+			Computed: true,
+		},
+	})
+}
+
+func (r *groupPolicyJumpGroupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.groupPolicyAssociationResource.ModifyPlan(ctx, req, resp)
+	if req.Plan.Raw.IsNull() || resp.Diagnostics.HasError() {
+		return
+	}
+
+	var jumpPolicyID types.Int64
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("jump_policy_id"), &jumpPolicyID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if api.IsPRA() {
+		if jumpPolicyID.IsNull() || jumpPolicyID.IsUnknown() {
+			jumpPolicyID = types.Int64Value(0)
+		}
+	} else {
+		jumpPolicyID = types.Int64Null()
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("jump_policy_id"), jumpPolicyID)...)
+}
@@ -0,0 +1,32 @@
+package rs
+
+import (
+	"context"
+	"terraform-provider-sra/api"
+	"terraform-provider-sra/bt/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// These throw away variable declarations are to allow the compiler to
+// enforce compliance to these interfaces
+var (
+	_ resource.Resource                = &groupPolicyJumpointResource{}
+	_ resource.ResourceWithConfigure   = &groupPolicyJumpointResource{}
+	_ resource.ResourceWithImportState = &groupPolicyJumpointResource{}
+	_ resource.ResourceWithIdentity    = &groupPolicyJumpointResource{}
+	_ resource.ResourceWithModifyPlan  = &groupPolicyJumpointResource{}
+)
+
+func newGroupPolicyJumpointResource() resource.Resource {
+	return &groupPolicyJumpointResource{}
+}
+
+type groupPolicyJumpointResource struct {
+	groupPolicyAssociationResource[api.GroupPolicyJumpoint, *api.GroupPolicyJumpoint, models.GroupPolicyJumpointAssociation]
+}
+
+func (r *groupPolicyJumpointResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = groupPolicyAssociationSchema("Manages the membership of a Jumpoint in a Group Policy.", "jumpoint_id", map[string]schema.Attribute{})
+}
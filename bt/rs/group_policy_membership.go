@@ -0,0 +1,227 @@
+package rs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"terraform-provider-sra/api"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	gpMembershipModeAdditive      = "additive"
+	gpMembershipModeAuthoritative = "authoritative"
+)
+
+func groupPolicyMembershipModeSchema() schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: `How group_policy_memberships is managed. "additive" only tracks the memberships in the configuration and ignores any others. "authoritative" detects every group policy membership of this object, and removes the ones that aren't in the configuration. Detecting them lists the members of every group policy, which is done once per run and shared by all the resources using "authoritative"`,
+		Optional:    true,
+		Computed:    true,
+		Default:     stringdefault.StaticString(gpMembershipModeAdditive),
+		Validators: []validator.String{
+			stringvalidator.OneOf(gpMembershipModeAdditive, gpMembershipModeAuthoritative),
+		},
+	}
+}
+
+// Refreshes the group_policy_memberships of the object with the given ID in the response state.
+func readGroupPolicyMemberships[T api.APIResource, PT api.GroupPolicyMembershipPtr[T]](ctx context.Context, c *api.APIClient, state tfsdk.State, resp *resource.ReadResponse, id int) {
+	var mode types.String
+	resp.Diagnostics.Append(state.GetAttribute(ctx, path.Root("group_policy_membership_mode"), &mode)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if mode.IsNull() {
+		// Imported items don't have a mode yet
+		mode = types.StringValue(gpMembershipModeAdditive)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group_policy_membership_mode"), mode)...)
+	}
+
+	var tfGPList types.Set
+	resp.Diagnostics.Append(state.GetAttribute(ctx, path.Root("group_policy_memberships"), &tfGPList)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var gpList []T
+	if !tfGPList.IsNull() {
+		resp.Diagnostics.Append(tfGPList.ElementsAs(ctx, &gpList, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	var err error
+	if mode.ValueString() == gpMembershipModeAuthoritative {
+		gpList, err = listGroupPolicyMemberships[T, PT](ctx, c, id)
+		if err == nil && len(gpList) == 0 && tfGPList.IsNull() {
+			// Keep the attribute null when there is nothing to manage
+			return
+		}
+	} else {
+		if tfGPList.IsNull() {
+			return
+		}
+		gpList, err = refreshGroupPolicyMemberships[T, PT](ctx, c, id, gpList)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading item's group policy memberships",
+			fmt.Sprintf("Unexpected reading memberships of item ID [%d]: %s", id, err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group_policy_memberships"), gpList)...)
+}
+
+// Re-reads the given memberships, dropping the ones that no longer exist
+func refreshGroupPolicyMemberships[T api.APIResource, PT api.GroupPolicyMembershipPtr[T]](ctx context.Context, c *api.APIClient, id int, gpList []T) ([]T, error) {
+	results := []T{}
+	for _, m := range gpList {
+		gpID := PT(&m).GroupPolicy()
+		endpoint := fmt.Sprintf("%s/%d", PT(&m).Endpoint(), id)
+		item, err := api.GetItemEndpoint[T](c, endpoint)
+		if api.IsNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("Item [%d] is no longer a member of group policy [%s]", id, gpID))
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		PT(item).SetMembership(gpID, id)
+		results = append(results, *item)
+	}
+	return results, nil
+}
+
+// Finds every group policy the object is a member of. The API can only list
+// the members of a group policy, so this checks each group policy in turn.
+// The lists are cached, see groupPolicyMemberCache
+func listGroupPolicyMemberships[T api.APIResource, PT api.GroupPolicyMembershipPtr[T]](ctx context.Context, c *api.APIClient, id int) ([]T, error) {
+	policies, err := cachedGroupPolicies(c)
+	if err != nil {
+		return nil, err
+	}
+
+	results := []T{}
+	for _, p := range policies {
+		gpID := fmt.Sprintf("%d", *p.ID)
+		var m T
+		PT(&m).SetMembership(gpID, id)
+		members, err := cachedGroupPolicyMembers[T](c, gpID, PT(&m).Endpoint())
+		if err != nil {
+			return nil, err
+		}
+		for _, member := range members {
+			if PT(&member).MemberID() != id {
+				continue
+			}
+			PT(&member).SetMembership(gpID, id)
+			results = append(results, member)
+		}
+	}
+	tflog.Trace(ctx, "🌈 Found group policy memberships", map[string]interface{}{
+		"item":        id,
+		"memberships": fmt.Sprintf("%+v", results),
+	})
+	return results, nil
+}
+
+// Sets the group_policy_memberships resulting from an update. A null plan stays
+// null, as Terraform expects the state to match the configuration
+func setGroupPolicyMemberships[T any](ctx context.Context, plan types.Set, state *tfsdk.State, results []T) diag.Diagnostics {
+	if plan.IsNull() {
+		results = nil
+	}
+	return state.SetAttribute(ctx, path.Root("group_policy_memberships"), results)
+}
+
+// Applies the planned group policy memberships of the object with the given ID,
+// and provisions the group policies that changed. Memberships whose attributes
+// changed are updated in place, so access isn't interrupted
+func applyGroupPolicyMemberships[T api.APIResource, PT api.GroupPolicyMembershipPtr[T]](ctx context.Context, c *api.APIClient, id int, planList []T, stateList []T) ([]T, error) {
+	toAdd, toUpdate, toRemove, noChange := api.DiffGroupPolicyMemberships[T, PT](planList, stateList)
+
+	tflog.Trace(ctx, "🌈 Updating group policy memberships", map[string]interface{}{
+		"add":      fmt.Sprintf("%+v", toAdd),
+		"update":   fmt.Sprintf("%+v", toUpdate),
+		"remove":   fmt.Sprintf("%+v", toRemove),
+		"noChange": fmt.Sprintf("%+v", noChange),
+	})
+
+	changed := []string{}
+	for _, list := range [][]T{toRemove, toUpdate, toAdd} {
+		for _, m := range list {
+			changed = append(changed, PT(&m).GroupPolicy())
+		}
+	}
+	if len(changed) == 0 {
+		return noChange, nil
+	}
+
+	batches := joinGroupPolicyBatches(changed)
+	results, err := changeGroupPolicyMemberships[T, PT](c, id, toAdd, toUpdate, toRemove)
+	forgetGroupPolicyMembers(c, changed)
+	provisionErr := leaveGroupPolicyBatches(ctx, c, batches)
+	if err = errors.Join(err, provisionErr); err != nil {
+		return nil, err
+	}
+
+	return append(noChange, results...), nil
+}
+
+func changeGroupPolicyMemberships[T api.APIResource, PT api.GroupPolicyMembershipPtr[T]](c *api.APIClient, id int, toAdd []T, toUpdate []T, toRemove []T) ([]T, error) {
+	for _, m := range toRemove {
+		gpID := PT(&m).GroupPolicy()
+		PT(&m).SetMembership(gpID, id)
+		endpoint := fmt.Sprintf("%s/%d", PT(&m).Endpoint(), id)
+		unlock := lockGroupPolicy(gpID)
+		err := api.DeleteItemEndpoint[T](c, endpoint)
+		unlock()
+		if err != nil && !api.IsNotFound(err) {
+			return nil, fmt.Errorf("removing membership of group policy [%s]: %w", gpID, err)
+		}
+	}
+
+	results := []T{}
+	for _, m := range toUpdate {
+		gpID := PT(&m).GroupPolicy()
+		PT(&m).SetMembership(gpID, id)
+		endpoint := fmt.Sprintf("%s/%d", PT(&m).Endpoint(), id)
+		unlock := lockGroupPolicy(gpID)
+		item, err := api.UpdateItemEndpoint(c, m, endpoint)
+		unlock()
+		if err != nil {
+			return nil, fmt.Errorf("updating membership of group policy [%s]: %w", gpID, err)
+		}
+		PT(item).SetMembership(gpID, id)
+		results = append(results, *item)
+	}
+
+	for _, m := range toAdd {
+		gpID := PT(&m).GroupPolicy()
+		PT(&m).SetMembership(gpID, id)
+		unlock := lockGroupPolicy(gpID)
+		item, err := api.CreateItem(c, m)
+		unlock()
+		if err != nil {
+			return nil, fmt.Errorf("adding membership of group policy [%s]: %w", gpID, err)
+		}
+		PT(item).SetMembership(gpID, id)
+		results = append(results, *item)
+	}
+
+	return results, nil
+}
@@ -0,0 +1,236 @@
+package rs
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"terraform-provider-sra/api"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadGroupPolicyMemberships(t *testing.T) {
+	ctx := context.Background()
+	responses := map[string]string{
+		"/jump-group/5":              `{"id":5,"name":"Group","code_name":"group","comments":""}`,
+		"/jump-group/7":              `{"id":7,"name":"Empty","code_name":"empty","comments":""}`,
+		"/group-policy":              `[{"id":1,"name":"One"},{"id":2,"name":"Two"}]`,
+		"/group-policy/1/jump-group": `[{"jump_group_id":5,"jump_item_role_id":2,"jump_policy_id":0},{"jump_group_id":6,"jump_item_role_id":1,"jump_policy_id":0}]`,
+		"/group-policy/2/jump-group": `[{"jump_group_id":5,"jump_item_role_id":0,"jump_policy_id":3}]`,
+		// Changed in the console since it was last read
+		"/group-policy/1/jump-group/5": `{"jump_group_id":5,"jump_item_role_id":2,"jump_policy_id":0}`,
+	}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if strings.HasSuffix(r.URL.Path, "oauth2/token") {
+			_, err := w.Write([]byte(`{"token_type":"Bearer","expires_in":3600,"access_token":"secret_access_granted"}`))
+			assert.Nil(t, err)
+			return
+		}
+		assert.Equal(t, http.MethodGet, r.Method)
+		for suffix, body := range responses {
+			if strings.HasSuffix(r.URL.Path, "/api/config/v1"+suffix) {
+				_, err := w.Write([]byte(body))
+				assert.Nil(t, err)
+				return
+			}
+		}
+		w.WriteHeader(http.StatusNotFound)
+		_, err := w.Write([]byte(`{"message":"Not Found"}`))
+		assert.Nil(t, err)
+	}))
+	defer ts.Close()
+
+	clientID := "id"
+	clientSecret := "🤐"
+	c, err := api.NewClient(ts.URL, &clientID, &clientSecret)
+	c.SetTest(t)
+	assert.Nil(t, err)
+
+	r := newJumpGroupResource().(*jumpGroupResource)
+	r.ApiClient = c
+
+	membership := func(gp string, role int, policy int) api.GroupPolicyJumpGroup {
+		return api.GroupPolicyJumpGroup{GroupPolicyID: &gp, JumpItemRoleID: role, JumpPolicyID: &policy}
+	}
+	read := func(attrs map[string]any) (types.String, types.Set, []api.GroupPolicyJumpGroup) {
+		state, identity := testState(t, r, attrs)
+		resp := resource.ReadResponse{State: state, Identity: identity}
+		r.Read(ctx, resource.ReadRequest{State: state}, &resp)
+		assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+		var mode types.String
+		var tfGPList types.Set
+		resp.State.GetAttribute(ctx, path.Root("group_policy_membership_mode"), &mode)
+		resp.State.GetAttribute(ctx, path.Root("group_policy_memberships"), &tfGPList)
+		var gpList []api.GroupPolicyJumpGroup
+		if !tfGPList.IsNull() {
+			assert.False(t, tfGPList.ElementsAs(ctx, &gpList, false).HasError())
+		}
+		sort.Slice(gpList, func(i, j int) bool { return *gpList[i].GroupPolicyID < *gpList[j].GroupPolicyID })
+		return mode, tfGPList, gpList
+	}
+
+	// Additive mode refreshes the memberships in state, drops the removed ones, and ignores the rest
+	mode, _, gpList := read(map[string]any{
+		"id":                           "5",
+		"group_policy_membership_mode": gpMembershipModeAdditive,
+		"group_policy_memberships":     []api.GroupPolicyJumpGroup{membership("1", 0, 0), membership("3", 0, 0)},
+	})
+	assert.Equal(t, gpMembershipModeAdditive, mode.ValueString())
+	assert.Equal(t, []api.GroupPolicyJumpGroup{membership("1", 2, 0)}, gpList)
+
+	// Authoritative mode finds every membership of the item
+	mode, _, gpList = read(map[string]any{
+		"id":                           "5",
+		"group_policy_membership_mode": gpMembershipModeAuthoritative,
+		"group_policy_memberships":     []api.GroupPolicyJumpGroup{membership("1", 0, 0)},
+	})
+	assert.Equal(t, gpMembershipModeAuthoritative, mode.ValueString())
+	assert.Equal(t, []api.GroupPolicyJumpGroup{membership("1", 2, 0), membership("2", 0, 3)}, gpList)
+
+	// Unmanaged memberships show up even when none are configured
+	_, tfGPList, gpList := read(map[string]any{
+		"id":                           "5",
+		"group_policy_membership_mode": gpMembershipModeAuthoritative,
+	})
+	assert.False(t, tfGPList.IsNull())
+	assert.Len(t, gpList, 2)
+
+	// But an item without memberships keeps them null
+	_, tfGPList, _ = read(map[string]any{
+		"id":                           "7",
+		"group_policy_membership_mode": gpMembershipModeAuthoritative,
+	})
+	assert.True(t, tfGPList.IsNull())
+
+	// Imported items default to additive mode
+	mode, tfGPList, _ = read(map[string]any{"id": "5"})
+	assert.Equal(t, gpMembershipModeAdditive, mode.ValueString())
+	assert.True(t, tfGPList.IsNull())
+}
+
+func TestApplyGroupPolicyMemberships(t *testing.T) {
+	ctx := context.Background()
+	requests := []string{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if strings.HasSuffix(r.URL.Path, "oauth2/token") {
+			_, err := w.Write([]byte(`{"token_type":"Bearer","expires_in":3600,"access_token":"secret_access_granted"}`))
+			assert.Nil(t, err)
+			return
+		}
+		requests = append(requests, r.Method+" "+strings.TrimPrefix(r.URL.Path, "/api/config/v1/"))
+		body, err := io.ReadAll(r.Body)
+		assert.Nil(t, err)
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		_, err = w.Write(body)
+		assert.Nil(t, err)
+	}))
+	defer ts.Close()
+
+	clientID := "id"
+	clientSecret := "🤐"
+	c, err := api.NewClient(ts.URL, &clientID, &clientSecret)
+	c.SetTest(t)
+	assert.Nil(t, err)
+
+	membership := func(gp string, role int) api.GroupPolicyJumpGroup {
+		policy := 0
+		return api.GroupPolicyJumpGroup{GroupPolicyID: &gp, JumpItemRoleID: role, JumpPolicyID: &policy}
+	}
+
+	results, err := applyGroupPolicyMemberships[api.GroupPolicyJumpGroup](ctx, c, 5,
+		[]api.GroupPolicyJumpGroup{membership("1", 2), membership("2", 0), membership("4", 0)},
+		[]api.GroupPolicyJumpGroup{membership("1", 1), membership("2", 0), membership("3", 0)},
+	)
+	assert.Nil(t, err)
+
+	// The role change is made in place, without removing the membership first
+	// Each changed group policy is provisioned afterwards
+	assert.Equal(t, []string{
+		"DELETE group-policy/3/jump-group/5",
+		"PATCH group-policy/1/jump-group/5",
+		"POST group-policy/4/jump-group",
+	}, requests[:3])
+	assert.ElementsMatch(t, []string{
+		"POST group-policy/1/provision",
+		"POST group-policy/3/provision",
+		"POST group-policy/4/provision",
+	}, requests[3:])
+
+	sort.Slice(results, func(i, j int) bool { return *results[i].GroupPolicyID < *results[j].GroupPolicyID })
+	assert.Len(t, results, 3)
+	for i, expected := range []api.GroupPolicyJumpGroup{membership("1", 2), membership("2", 0), membership("4", 0)} {
+		assert.Equal(t, *expected.GroupPolicyID, *results[i].GroupPolicyID)
+		assert.Equal(t, expected.JumpItemRoleID, results[i].JumpItemRoleID)
+	}
+}
+
+func TestGroupPolicyMemberCache(t *testing.T) {
+	ctx := context.Background()
+	requests := map[string]int{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if strings.HasSuffix(r.URL.Path, "oauth2/token") {
+			_, err := w.Write([]byte(`{"token_type":"Bearer","expires_in":3600,"access_token":"secret_access_granted"}`))
+			assert.Nil(t, err)
+			return
+		}
+		path := strings.TrimPrefix(r.URL.Path, "/api/config/v1/")
+		requests[r.Method+" "+path]++
+		var body string
+		switch path {
+		case "group-policy":
+			body = `[{"id":1,"name":"One"},{"id":2,"name":"Two"}]`
+		case "group-policy/1/jump-group":
+			body = `[{"jump_group_id":5,"jump_item_role_id":2,"jump_policy_id":0}]`
+		case "group-policy/2/jump-group":
+			body = `[{"jump_group_id":7,"jump_item_role_id":1,"jump_policy_id":0}]`
+		default:
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		_, err := w.Write([]byte(body))
+		assert.Nil(t, err)
+	}))
+	defer ts.Close()
+
+	clientID := "id"
+	clientSecret := "🤐"
+	c, err := api.NewClient(ts.URL, &clientID, &clientSecret)
+	c.SetTest(t)
+	assert.Nil(t, err)
+
+	// Every item is found from the same lists
+	for _, id := range []int{5, 7, 9} {
+		results, err := listGroupPolicyMemberships[api.GroupPolicyJumpGroup](ctx, c, id)
+		assert.Nil(t, err)
+		assert.Len(t, results, map[int]int{5: 1, 7: 1, 9: 0}[id])
+	}
+	assert.Equal(t, map[string]int{
+		"GET group-policy":              1,
+		"GET group-policy/1/jump-group": 1,
+		"GET group-policy/2/jump-group": 1,
+	}, requests)
+
+	// Until the members of a group policy change
+	gpID := "1"
+	_, err = applyGroupPolicyMemberships[api.GroupPolicyJumpGroup](ctx, c, 5, nil, []api.GroupPolicyJumpGroup{{GroupPolicyID: &gpID}})
+	assert.Nil(t, err)
+	_, err = listGroupPolicyMemberships[api.GroupPolicyJumpGroup](ctx, c, 5)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, requests["GET group-policy/1/jump-group"])
+	assert.Equal(t, 1, requests["GET group-policy/2/jump-group"])
+	assert.Equal(t, 1, requests["GET group-policy"])
+}
@@ -0,0 +1,42 @@
+package rs
+
+import (
+	"context"
+	"terraform-provider-sra/api"
+	"terraform-provider-sra/bt/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// These throw away variable declarations are to allow the compiler to
+// enforce compliance to these interfaces
+var (
+	_ resource.Resource                = &groupPolicyVaultAccountResource{}
+	_ resource.ResourceWithConfigure   = &groupPolicyVaultAccountResource{}
+	_ resource.ResourceWithImportState = &groupPolicyVaultAccountResource{}
+	_ resource.ResourceWithIdentity    = &groupPolicyVaultAccountResource{}
+	_ resource.ResourceWithModifyPlan  = &groupPolicyVaultAccountResource{}
+)
+
+func newGroupPolicyVaultAccountResource() resource.Resource {
+	return &groupPolicyVaultAccountResource{}
+}
+
+type groupPolicyVaultAccountResource struct {
+	groupPolicyAssociationResource[api.GroupPolicyVaultAccount, *api.GroupPolicyVaultAccount, models.GroupPolicyVaultAccountAssociation]
+}
+
+func (r *groupPolicyVaultAccountResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = groupPolicyAssociationSchema("Manages the membership of a Vault Account in a Group Policy.", "account_id", map[string]schema.Attribute{
+		"role": schema.StringAttribute{
+			Description: "The role of the Group Policy's members for this Vault Account",
+			Required:    true,
+			Validators: []validator.String{
+				stringvalidator.OneOf([]string{"inject", "inject_and_checkout"}...),
+			},
+		},
+	})
+}
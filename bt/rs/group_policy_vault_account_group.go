@@ -0,0 +1,42 @@
+package rs
+
+import (
+	"context"
+	"terraform-provider-sra/api"
+	"terraform-provider-sra/bt/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// These throw away variable declarations are to allow the compiler to
+// enforce compliance to these interfaces
+var (
+	_ resource.Resource                = &groupPolicyVaultAccountGroupResource{}
+	_ resource.ResourceWithConfigure   = &groupPolicyVaultAccountGroupResource{}
+	_ resource.ResourceWithImportState = &groupPolicyVaultAccountGroupResource{}
+	_ resource.ResourceWithIdentity    = &groupPolicyVaultAccountGroupResource{}
+	_ resource.ResourceWithModifyPlan  = &groupPolicyVaultAccountGroupResource{}
+)
+
+func newGroupPolicyVaultAccountGroupResource() resource.Resource {
+	return &groupPolicyVaultAccountGroupResource{}
+}
+
+type groupPolicyVaultAccountGroupResource struct {
+	groupPolicyAssociationResource[api.GroupPolicyVaultAccountGroup, *api.GroupPolicyVaultAccountGroup, models.GroupPolicyVaultAccountGroupAssociation]
+}
+
+func (r *groupPolicyVaultAccountGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = groupPolicyAssociationSchema("Manages the membership of a Vault Account Group in a Group Policy.", "account_group_id", map[string]schema.Attribute{
+		"role": schema.StringAttribute{
+			Description: "The role of the Group Policy's members for this Vault Account Group",
+			Required:    true,
+			Validators: []validator.String{
+				stringvalidator.OneOf([]string{"inject", "inject_and_checkout"}...),
+			},
+		},
+	})
+}
@@ -0,0 +1,185 @@
+package rs
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"terraform-provider-sra/api"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+/*
+Resource identities. Items are identified by their ID and the product of the appliance they were created on,
+since IDs are only unique within a single appliance. Vault Accounts of every type share the same IDs, so their
+identity also includes the account type. Memberships are identified by the IDs of both sides of the membership.
+
+The framework only supplies an identity to resources that declare an identity schema, so the set functions
+here do nothing when identity is nil.
+*/
+
+func itemIdentitySchema(withType bool) identityschema.Schema {
+	s := identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "The ID of the item",
+				RequiredForImport: true,
+			},
+			"product": identityschema.StringAttribute{
+				Description:       "The product of the appliance the item is on, either \"pra\" or \"rs\"",
+				OptionalForImport: true,
+			},
+		},
+	}
+
+	if withType {
+		s.Attributes["type"] = identityschema.StringAttribute{
+			Description:       "The type of the item",
+			OptionalForImport: true,
+		}
+	}
+
+	return s
+}
+
+func identityProduct() string {
+	return strings.ToLower(api.ProductName())
+}
+
+// Sets the ID and product of an item identity
+func setIdentityID(ctx context.Context, identity *tfsdk.ResourceIdentity, id types.String) diag.Diagnostics {
+	if identity == nil {
+		return nil
+	}
+
+	diags := identity.SetAttribute(ctx, path.Root("id"), id)
+	diags.Append(identity.SetAttribute(ctx, path.Root("product"), identityProduct())...)
+	return diags
+}
+
+// Removes a resource that no longer exists from the state during Read. The framework still expects an
+// identity in the response when the resource is removed, so the caller passes the result of setting it,
+// from setIdentityID or setCompositeIdentity
+func removeFromState(ctx context.Context, resp *resource.ReadResponse, identityDiags diag.Diagnostics) {
+	resp.Diagnostics.Append(identityDiags...)
+	resp.State.RemoveResource(ctx)
+}
+
+// Returns the ID from an item identity given to import, after checking it is for this appliance's product
+func idFromIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity) (string, diag.Diagnostics) {
+	var id, product types.String
+	diags := identity.GetAttribute(ctx, path.Root("id"), &id)
+	diags.Append(identity.GetAttribute(ctx, path.Root("product"), &product)...)
+	if diags.HasError() {
+		return "", diags
+	}
+
+	if !product.IsNull() && product.ValueString() != identityProduct() {
+		diags.AddAttributeError(
+			path.Root("product"),
+			"Invalid import identity",
+			fmt.Sprintf("The identity is for a %s item, but BT_API_HOST is configured for a %s site.", product.ValueString(), identityProduct()),
+		)
+	}
+
+	return id.ValueString(), diags
+}
+
+func compositeIdentitySchema(parentAttr string, childAttr string) identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       fmt.Sprintf("The ID in the form <%s>/<%s>", parentAttr, childAttr),
+				OptionalForImport: true,
+			},
+			parentAttr: identityschema.Int64Attribute{
+				RequiredForImport: true,
+			},
+			childAttr: identityschema.Int64Attribute{
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+// Sets a membership identity from the composite ID of the membership
+func setCompositeIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, id types.String, parentAttr string, childAttr string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if identity == nil {
+		return diags
+	}
+
+	parentID, childID, err := parseCompositeID(id.ValueString())
+	if err != nil {
+		diags.AddError("Error setting identity", err.Error())
+		return diags
+	}
+
+	diags.Append(identity.SetAttribute(ctx, path.Root("id"), id)...)
+	diags.Append(identity.SetAttribute(ctx, path.Root(parentAttr), int64(parentID))...)
+	diags.Append(identity.SetAttribute(ctx, path.Root(childAttr), int64(childID))...)
+	return diags
+}
+
+// Returns the composite ID for a membership identity given to import
+func compositeIDFromIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, parentAttr string, childAttr string) (string, diag.Diagnostics) {
+	var parentID, childID types.Int64
+	diags := identity.GetAttribute(ctx, path.Root(parentAttr), &parentID)
+	diags.Append(identity.GetAttribute(ctx, path.Root(childAttr), &childID)...)
+	if diags.HasError() {
+		return "", diags
+	}
+
+	return compositeID(int(parentID.ValueInt64()), int(childID.ValueInt64())), diags
+}
+
+func typedCompositeIdentitySchema(parentAttr string, typeAttr string, childAttr string) identityschema.Schema {
+	s := compositeIdentitySchema(parentAttr, childAttr)
+	s.Attributes["id"] = identityschema.StringAttribute{
+		Description:       fmt.Sprintf("The ID in the form <%s>/<%s>/<%s>", parentAttr, typeAttr, childAttr),
+		OptionalForImport: true,
+	}
+	s.Attributes[typeAttr] = identityschema.StringAttribute{
+		RequiredForImport: true,
+	}
+	return s
+}
+
+// Sets a membership identity from a composite ID that includes the type of the member
+func setTypedCompositeIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, id types.String, parentAttr string, typeAttr string, childAttr string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if identity == nil {
+		return diags
+	}
+
+	parentID, childType, childID, err := parseTypedCompositeID(id.ValueString())
+	if err != nil {
+		diags.AddError("Error setting identity", err.Error())
+		return diags
+	}
+
+	diags.Append(identity.SetAttribute(ctx, path.Root("id"), id)...)
+	diags.Append(identity.SetAttribute(ctx, path.Root(parentAttr), int64(parentID))...)
+	diags.Append(identity.SetAttribute(ctx, path.Root(typeAttr), childType)...)
+	diags.Append(identity.SetAttribute(ctx, path.Root(childAttr), int64(childID))...)
+	return diags
+}
+
+// Returns the composite ID, including the type of the member, for a membership identity given to import
+func typedCompositeIDFromIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, parentAttr string, typeAttr string, childAttr string) (string, diag.Diagnostics) {
+	var parentID, childID types.Int64
+	var childType types.String
+	diags := identity.GetAttribute(ctx, path.Root(parentAttr), &parentID)
+	diags.Append(identity.GetAttribute(ctx, path.Root(typeAttr), &childType)...)
+	diags.Append(identity.GetAttribute(ctx, path.Root(childAttr), &childID)...)
+	if diags.HasError() {
+		return "", diags
+	}
+
+	return typedCompositeID(int(parentID.ValueInt64()), childType.ValueString(), int(childID.ValueInt64())), diags
+}
@@ -0,0 +1,81 @@
+package rs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestItemIdentity(t *testing.T) {
+	ctx := context.Background()
+	s := itemIdentitySchema(false)
+	identity := &tfsdk.ResourceIdentity{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+
+	diags := setIdentityID(ctx, identity, types.StringValue("42"))
+	assert.False(t, diags.HasError())
+
+	id, diags := idFromIdentity(ctx, identity)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "42", id)
+
+	// Identities from the other product are for items on a different appliance
+	diags = identity.SetAttribute(ctx, path.Root("product"), "rs")
+	assert.False(t, diags.HasError())
+	_, diags = idFromIdentity(ctx, identity)
+	assert.True(t, diags.HasError())
+
+	// The product is optional when importing
+	diags = identity.SetAttribute(ctx, path.Root("product"), types.StringNull())
+	assert.False(t, diags.HasError())
+	id, diags = idFromIdentity(ctx, identity)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "42", id)
+
+	// Nothing to set when the resource has no identity
+	assert.Nil(t, setIdentityID(ctx, nil, types.StringValue("42")))
+}
+
+func TestCompositeIdentity(t *testing.T) {
+	ctx := context.Background()
+	s := compositeIdentitySchema("jumpoint_id", "user_id")
+	identity := &tfsdk.ResourceIdentity{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+
+	diags := setCompositeIdentity(ctx, identity, types.StringValue("3/7"), "jumpoint_id", "user_id")
+	assert.False(t, diags.HasError())
+
+	var userID types.Int64
+	identity.GetAttribute(ctx, path.Root("user_id"), &userID)
+	assert.Equal(t, int64(7), userID.ValueInt64())
+
+	id, diags := compositeIDFromIdentity(ctx, identity, "jumpoint_id", "user_id")
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "3/7", id)
+
+	diags = setCompositeIdentity(ctx, identity, types.StringValue("3"), "jumpoint_id", "user_id")
+	assert.True(t, diags.HasError())
+}
+
+func TestTypedCompositeIdentity(t *testing.T) {
+	ctx := context.Background()
+	s := typedCompositeIdentitySchema("account_id", "jump_item_type", "jump_item_id")
+	identity := &tfsdk.ResourceIdentity{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+
+	diags := setTypedCompositeIdentity(ctx, identity, types.StringValue("3/web_jump/7"), "account_id", "jump_item_type", "jump_item_id")
+	assert.False(t, diags.HasError())
+
+	var jumpItemType types.String
+	identity.GetAttribute(ctx, path.Root("jump_item_type"), &jumpItemType)
+	assert.Equal(t, "web_jump", jumpItemType.ValueString())
+
+	id, diags := typedCompositeIDFromIdentity(ctx, identity, "account_id", "jump_item_type", "jump_item_id")
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "3/web_jump/7", id)
+
+	diags = setTypedCompositeIdentity(ctx, identity, types.StringValue("3/7"), "account_id", "jump_item_type", "jump_item_id")
+	assert.True(t, diags.HasError())
+}
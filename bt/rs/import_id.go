@@ -0,0 +1,219 @@
+package rs
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"terraform-provider-sra/api"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+/*
+Besides the numeric ID, the generic ImportState accepts IDs that are looked up through the API:
+  - code_name:<code name> for items that have a code name, like Jump Groups and Jumpoints
+  - name:<name> for items that have a name, like Jump Items and Vault Accounts
+  - <jump group id>/name:<name> for Jump Items, when the name alone is not unique
+  - any other non-numeric ID is taken as a name, so it must match an item with that name
+
+The lookup lists the items using the API filters, then keeps only the items that match every filter,
+since the API filters are not guaranteed to be exact. Like the API filters, names and code names match
+regardless of case. Exactly one item must match, so items whose names only differ by case can't be
+imported by name.
+*/
+
+const (
+	importCodeNamePrefix = "code_name:"
+	importNamePrefix     = "name:"
+)
+
+// Returns the numeric ID of the item referred to by the import ID
+func (r *apiResource[TApi, TTf]) resolveImportID(ctx context.Context, importID string) (string, error) {
+	var tmp TApi
+	apiType := reflect.TypeOf(tmp)
+	_, hasCodeName := apiType.FieldByName("CodeName")
+	_, hasName := apiType.FieldByName("Name")
+	_, hasJumpGroup := apiType.FieldByName("JumpGroupID")
+
+	var value string
+	filter := map[string]string{}
+	switch {
+	case strings.HasPrefix(importID, importCodeNamePrefix):
+		if !hasCodeName {
+			return "", fmt.Errorf("%s can't be imported by code_name", r.printableName())
+		}
+		value = strings.TrimPrefix(importID, importCodeNamePrefix)
+		filter["code_name"] = value
+	case strings.HasPrefix(importID, importNamePrefix):
+		if !hasName {
+			return "", fmt.Errorf("%s can't be imported by name", r.printableName())
+		}
+		value = strings.TrimPrefix(importID, importNamePrefix)
+		filter["name"] = value
+	case strings.Contains(importID, "/"+importNamePrefix):
+		if !hasJumpGroup {
+			return "", fmt.Errorf("%s can't be imported by jump group and name", r.printableName())
+		}
+		parts := strings.SplitN(importID, "/"+importNamePrefix, 2)
+		if _, err := strconv.Atoi(parts[0]); err != nil {
+			return "", fmt.Errorf("the jump group in import ID [%s] must be a numeric jump group ID", importID)
+		}
+		value = parts[1]
+		filter["name"] = value
+		filter["jump_group_id"] = parts[0]
+	default:
+		if _, err := strconv.Atoi(importID); err == nil {
+			return importID, nil
+		}
+		if !hasName {
+			return "", fmt.Errorf("import ID [%s] must be a numeric %s ID", importID, r.printableName())
+		}
+		value = importID
+		filter["name"] = value
+	}
+
+	if value == "" {
+		return "", fmt.Errorf("import ID [%s] is missing the value to look up", importID)
+	}
+
+	tflog.Debug(ctx, "🙀 looking up import ID", map[string]interface{}{
+		"data": filter,
+	})
+	matches, err := r.lookupItems(filter, true)
+	if err != nil {
+		return "", err
+	}
+	ids := matchedIDs(matches)
+
+	switch len(matches) {
+	case 0:
+		if importID == value {
+			return "", fmt.Errorf("no %s named [%s], import IDs that aren't numeric are looked up by name", r.printableName(), importID)
+		}
+		return "", fmt.Errorf("no %s found for import ID [%s]", r.printableName(), importID)
+	case 1:
+		return ids[0], nil
+	default:
+		hint := ""
+		if spellings := matchedSpellings(matches, filter); len(spellings) > 1 {
+			hint = fmt.Sprintf(" Their names only differ by case (%s), import one of them by its numeric ID.", strings.Join(spellings, ", "))
+		} else if hasJumpGroup && !strings.Contains(importID, "/") {
+			hint = fmt.Sprintf(" Qualify the name with a jump group, like <jump_group_id>/%s%s", importNamePrefix, value)
+		}
+		return "", fmt.Errorf("found %d %s items for import ID [%s] (IDs %s), only one can be imported.%s", len(matches), r.printableName(), importID, strings.Join(ids, ", "), hint)
+	}
+}
+
+// Returns the IDs of the items the API filter finds whose JSON fields exactly match every value of the filter
+func (r *apiResource[TApi, TTf]) lookupIDs(filter map[string]string) ([]string, error) {
+	matches, err := r.lookupItems(filter, false)
+	if err != nil {
+		return nil, err
+	}
+	return matchedIDs(matches), nil
+}
+
+// Returns the JSON fields of the items the API filter finds that match every value of the filter, ignoring
+// case when ignoreCase is set
+func (r *apiResource[TApi, TTf]) lookupItems(filter map[string]string, ignoreCase bool) ([]map[string]string, error) {
+	items, err := api.ListItems[TApi](r.ApiClient, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var tmp TApi
+	accountTypes := vaultAccountTypes(tmp)
+	matches := []map[string]string{}
+	for _, item := range items {
+		fields, err := jsonFieldStrings(item)
+		if err != nil {
+			return nil, err
+		}
+		if !matchesFilter(fields, filter, ignoreCase) {
+			continue
+		}
+		if len(accountTypes) > 0 && !slices.Contains(accountTypes, fields["type"]) {
+			continue
+		}
+		matches = append(matches, fields)
+	}
+	return matches, nil
+}
+
+func matchesFilter(fields map[string]string, filter map[string]string, ignoreCase bool) bool {
+	for name, value := range filter {
+		if ignoreCase && !strings.EqualFold(fields[name], value) {
+			return false
+		}
+		if !ignoreCase && fields[name] != value {
+			return false
+		}
+	}
+	return true
+}
+
+func matchedIDs(matches []map[string]string) []string {
+	ids := []string{}
+	for _, fields := range matches {
+		ids = append(ids, fields["id"])
+	}
+	return ids
+}
+
+// Returns the different ways the matches spell the name or code name that was looked up
+func matchedSpellings(matches []map[string]string, filter map[string]string) []string {
+	spellings := []string{}
+	for _, name := range []string{"name", "code_name"} {
+		if _, ok := filter[name]; !ok {
+			continue
+		}
+		for _, fields := range matches {
+			if !slices.Contains(spellings, fields[name]) {
+				spellings = append(spellings, fields[name])
+			}
+		}
+	}
+	return spellings
+}
+
+// Returns the top level JSON fields of the item as strings, the way they're given to the API filters
+func jsonFieldStrings(item any) (map[string]string, error) {
+	rb, err := json.Marshal(item)
+	if err != nil {
+		return nil, err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(rb))
+	decoder.UseNumber()
+	var values map[string]any
+	if err := decoder.Decode(&values); err != nil {
+		return nil, err
+	}
+
+	fields := map[string]string{}
+	for name, v := range values {
+		if v != nil {
+			fields[name] = fmt.Sprint(v)
+		}
+	}
+	return fields, nil
+}
+
+// The API has one endpoint for every type of Vault Account, so the resources for each type
+// need to only look at the accounts of their own types
+func vaultAccountTypes(item any) []string {
+	switch item.(type) {
+	case api.VaultSSHAccount:
+		return []string{"ssh", "ssh_ca"}
+	case api.VaultUsernamePasswordAccount:
+		return []string{"username_password"}
+	case api.VaultTokenAccount:
+		return []string{"opaque_token"}
+	}
+
+	return nil
+}
@@ -0,0 +1,109 @@
+package rs
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"terraform-provider-sra/api"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolveImportID(t *testing.T) {
+	ctx := context.Background()
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		var body string
+		switch {
+		case strings.HasSuffix(r.URL.Path, "oauth2/token"):
+			body = `{"token_type":"Bearer","expires_in":3600,"access_token":"secret_access_granted"}`
+		case strings.HasSuffix(r.URL.Path, "jump-group"):
+			assert.Equal(t, "code_name=linux", r.URL.RawQuery)
+			body = `[{"id":3,"name":"Linux","code_name":"linux"},{"id":4,"name":"Linux Old","code_name":"linux_old"}]`
+		case strings.HasSuffix(r.URL.Path, "jump-item/shell-jump"):
+			if r.URL.Query().Get("jump_group_id") == "7" {
+				body = `[{"id":12,"name":"db","jump_group_id":7}]`
+			} else {
+				body = `[{"id":11,"name":"db","jump_group_id":6},{"id":12,"name":"db","jump_group_id":7},{"id":13,"name":"db2","jump_group_id":7}]`
+			}
+		case strings.HasSuffix(r.URL.Path, "jump-item/web-jump"):
+			body = `[{"id":31,"name":"Portal","jump_group_id":1},{"id":32,"name":"portal","jump_group_id":2},{"id":33,"name":"Wiki","jump_group_id":1}]`
+		case strings.HasSuffix(r.URL.Path, "vault/account"):
+			body = `[{"id":21,"name":"root","type":"ssh"},{"id":22,"name":"root","type":"username_password"}]`
+		default:
+			assert.Fail(t, "Bad request", r.URL)
+		}
+		_, err := w.Write([]byte(body))
+		assert.Nil(t, err)
+	}))
+	defer ts.Close()
+
+	clientID := "id"
+	clientSecret := "🤐"
+	c, err := api.NewClient(ts.URL, &clientID, &clientSecret)
+	c.SetTest(t)
+	assert.Nil(t, err)
+
+	jumpGroup := newJumpGroupResource().(*jumpGroupResource)
+	jumpGroup.ApiClient = c
+	shellJump := newShellJumpResource().(*shellJumpResource)
+	shellJump.ApiClient = c
+	webJump := newWebJumpResource().(*webJumpResource)
+	webJump.ApiClient = c
+	userPass := newVaultUsernamePasswordAccountResource().(*vaultUsernamePasswordAccountResource)
+	userPass.ApiClient = c
+
+	// Numeric IDs don't need a lookup
+	id, err := shellJump.resolveImportID(ctx, "42")
+	assert.Nil(t, err)
+	assert.Equal(t, "42", id)
+
+	// Only exact matches count
+	id, err = jumpGroup.resolveImportID(ctx, "code_name:linux")
+	assert.Nil(t, err)
+	assert.Equal(t, "3", id)
+
+	_, err = shellJump.resolveImportID(ctx, "code_name:linux")
+	assert.ErrorContains(t, err, "can't be imported by code_name")
+
+	_, err = shellJump.resolveImportID(ctx, "name:db")
+	assert.ErrorContains(t, err, "found 2 shell_jump items")
+	assert.ErrorContains(t, err, "<jump_group_id>/name:db")
+
+	id, err = shellJump.resolveImportID(ctx, "7/name:db")
+	assert.Nil(t, err)
+	assert.Equal(t, "12", id)
+
+	_, err = shellJump.resolveImportID(ctx, "name:web")
+	assert.ErrorContains(t, err, "no shell_jump found")
+
+	// Other IDs are names, which must exist
+	id, err = shellJump.resolveImportID(ctx, "db2")
+	assert.Nil(t, err)
+	assert.Equal(t, "13", id)
+
+	_, err = shellJump.resolveImportID(ctx, "web")
+	assert.ErrorContains(t, err, "no shell_jump named [web]")
+
+	_, err = shellJump.resolveImportID(ctx, "db")
+	assert.ErrorContains(t, err, "<jump_group_id>/name:db")
+
+	// Names match regardless of case, like the API filters
+	id, err = webJump.resolveImportID(ctx, "name:wiki")
+	assert.Nil(t, err)
+	assert.Equal(t, "33", id)
+
+	_, err = webJump.resolveImportID(ctx, "PORTAL")
+	assert.ErrorContains(t, err, "found 2 web_jump items")
+	assert.ErrorContains(t, err, "only differ by case (Portal, portal)")
+
+	_, err = userPass.resolveImportID(ctx, "7/name:root")
+	assert.ErrorContains(t, err, "can't be imported by jump group and name")
+
+	// Accounts of other types share the endpoint, but aren't matched
+	id, err = userPass.resolveImportID(ctx, "name:root")
+	assert.Nil(t, err)
+	assert.Equal(t, "22", id)
+}
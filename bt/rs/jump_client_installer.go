@@ -36,8 +36,11 @@ type jumpClientInstallerResource struct {
 	apiResource[api.JumpClientInstaller, models.JumpClientInstaller]
 }
 
+const jumpClientInstallerSchemaVersion int64 = 1
+
 func (r *jumpClientInstallerResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: jumpClientInstallerSchemaVersion,
 		MarkdownDescription: `Manages a Jump Client Installer.
 
 		*NOTE*: It is not recommended to use any installers managed by Terraform outside of Terraform
@@ -51,6 +54,10 @@ func (r *jumpClientInstallerResource) Schema(_ context.Context, _ resource.Schem
 	}
 }
 
+func (r *jumpClientInstallerResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(jumpClientInstallerSchemaVersion, nil)
+}
+
 func (r jumpClientInstallerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	// Mark all attributes in the schema that can be supplied as requiring a full replacement
 	// of the item since we don't allow modification of existing installers
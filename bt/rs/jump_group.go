@@ -2,13 +2,10 @@ package rs
 
 import (
 	"context"
-	"fmt"
 	"strconv"
-	"sync"
 	"terraform-provider-sra/api"
 	"terraform-provider-sra/bt/models"
 
-	mapset "github.com/deckarep/golang-set/v2"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -27,11 +24,6 @@ var (
 	_ resource.ResourceWithConfigure   = &jumpGroupResource{}
 	_ resource.ResourceWithImportState = &jumpGroupResource{}
 	_ resource.ResourceWithModifyPlan  = &jumpGroupResource{}
-
-	// Because of the way the PHP code handles changing memberships, those
-	// operations cannot be done in parallel. We use this mutex to ensure
-	// we deal with membership updates one at a time
-	jgMembershipMutex sync.Mutex
 )
 
 func newJumpGroupResource() resource.Resource {
@@ -42,8 +34,11 @@ type jumpGroupResource struct {
 	apiResource[api.JumpGroup, models.JumpGroup]
 }
 
+const jumpGroupSchemaVersion int64 = 1
+
 func (r *jumpGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:     jumpGroupSchemaVersion,
 		Description: "Manages a Jump Group.\n\nFor descriptions of individual fields, please see the Configuration API documentation on your SRA Appliance",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -52,6 +47,8 @@ func (r *jumpGroupResource) Schema(_ context.Context, _ resource.SchemaRequest,
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"adopt_existing":      adoptExistingSchema(),
+			"deletion_protection": deletionProtectionSchema(),
 			"name": schema.StringAttribute{
 				Required: true,
 			},
@@ -64,6 +61,7 @@ func (r *jumpGroupResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Default:  stringdefault.StaticString(""),
 			},
 
+			"group_policy_membership_mode": groupPolicyMembershipModeSchema(),
 			"group_policy_memberships": schema.SetNestedAttribute{
 				Optional: true,
 				NestedObject: schema.NestedAttributeObject{
@@ -92,12 +90,18 @@ This field only applies to PRA`,
 	}
 }
 
+func (r *jumpGroupResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(jumpGroupSchemaVersion, nil)
+}
+
 func (r *jumpGroupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	tflog.Debug(ctx, "Starting plan modification")
+	warnDeletionProtection(ctx, req, resp, r.printableName())
 	if req.Plan.Raw.IsNull() {
 		tflog.Debug(ctx, "No plan to modify")
 		return
 	}
+	checkInlineMemberships(ctx, req.Plan, "jump_group_id", "group_policy_jump_group", &resp.Diagnostics)
 
 	/*
 		Here we are setting some things that get defaults if they are not supplied.
@@ -167,55 +171,13 @@ func (r *jumpGroupResource) Create(ctx context.Context, req resource.CreateReque
 			return
 		}
 
-		toAdd := mapset.NewSet(gpList...)
-
-		tflog.Trace(ctx, "🌈 Updating group policy memberships", map[string]interface{}{
-			"add": toAdd,
-
-			"tf":   tfGPList,
-			"list": gpList,
-		})
-
-		jgMembershipMutex.Lock()
-		defer jgMembershipMutex.Unlock()
-
-		results := []api.GroupPolicyJumpGroup{}
-		needsProvision := mapset.NewSet[string]()
-		for m := range toAdd.Iterator().C {
-			m.JumpGroupID = &id
-			tflog.Trace(ctx, "🌈 Adding item", map[string]interface{}{
-				"add":         fmt.Sprintf("%+v", m),
-				"gp":          m.GroupPolicyID,
-				"jump group":  m.JumpGroupID,
-				"jump policy": m.JumpPolicyID,
-			})
-			item, err := api.CreateItem(r.ApiClient, m)
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					"Error adding item's group policy memberships",
-					"Unexpected adding membership of item ID ["+strconv.Itoa(id)+"]: "+err.Error(),
-				)
-				return
-			}
-			item.GroupPolicyID = m.GroupPolicyID
-			results = append(results, *item)
-			needsProvision.Add(*m.GroupPolicyID)
-		}
-
-		for id := range needsProvision.Iter() {
-			p := api.GroupPolicyProvision{
-				GroupPolicyID: &id,
-			}
-			_, err := api.CreateItem(r.ApiClient, p)
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					"Error provisioning item's group policy memberships",
-					"Unexpected response provisioning membership of item ID ["+*p.GroupPolicyID+"]: "+err.Error(),
-				)
-				return
-			}
+		results, err := applyGroupPolicyMemberships[api.GroupPolicyJumpGroup](ctx, r.ApiClient, id, gpList, nil)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error adding item's group policy memberships",
+				"Unexpected adding memberships of item ID ["+strconv.Itoa(id)+"]: "+err.Error(),
+			)
+			return
 		}
 
 		diags = resp.State.SetAttribute(ctx, path.Root("group_policy_memberships"), results)
@@ -230,7 +192,7 @@ func (r *jumpGroupResource) Create(ctx context.Context, req resource.CreateReque
 
 func (r *jumpGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	r.apiResource.Read(ctx, req, resp)
-	if resp.Diagnostics.HasError() {
+	if resp.Diagnostics.HasError() || resourceGone(resp) {
 		return
 	}
 
@@ -238,57 +200,7 @@ func (r *jumpGroupResource) Read(ctx context.Context, req resource.ReadRequest,
 	req.State.GetAttribute(ctx, path.Root("id"), &tfId)
 	id, _ := strconv.Atoi(tfId.ValueString())
 
-	{
-		// Group Policy Memberships
-
-		var tfGPList types.Set
-		diags := req.State.GetAttribute(ctx, path.Root("group_policy_memberships"), &tfGPList)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-
-		if tfGPList.IsNull() {
-			return
-		}
-
-		var gpList []api.GroupPolicyJumpGroup
-		diags = tfGPList.ElementsAs(ctx, &gpList, false)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-
-		for i, m := range gpList {
-			m.JumpGroupID = &id
-			tflog.Trace(ctx, "🌈 Reading item", map[string]interface{}{
-				"read": m,
-			})
-			gpId := *m.GroupPolicyID
-
-			endpoint := fmt.Sprintf("%s/%d", m.Endpoint(), id)
-			item, err := api.GetItemEndpoint[api.GroupPolicyJumpGroup](r.ApiClient, endpoint)
-
-			if err != nil {
-				tflog.Trace(ctx, "🌈 Error reading item item, skipping", map[string]interface{}{
-					"read":  m,
-					"error": err,
-				})
-			} else if item != nil {
-				tflog.Trace(ctx, "🌈 Read item", map[string]interface{}{
-					"read": *item,
-				})
-				item.GroupPolicyID = &gpId
-				gpList[i] = *item
-			}
-		}
-
-		diags = resp.State.SetAttribute(ctx, path.Root("group_policy_memberships"), gpList)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-	}
+	readGroupPolicyMemberships[api.GroupPolicyJumpGroup](ctx, r.ApiClient, req.State, resp, id)
 }
 
 func (r *jumpGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -311,13 +223,12 @@ func (r *jumpGroupResource) Update(ctx context.Context, req resource.UpdateReque
 		}
 
 		var gpList []api.GroupPolicyJumpGroup
-		if tfGPList.IsNull() {
-			return
-		}
-		diags = tfGPList.ElementsAs(ctx, &gpList, false)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
+		if !tfGPList.IsNull() {
+			diags = tfGPList.ElementsAs(ctx, &gpList, false)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
 		}
 
 		var tfGPStateList types.Set
@@ -336,80 +247,20 @@ func (r *jumpGroupResource) Update(ctx context.Context, req resource.UpdateReque
 			}
 		}
 
-		toAdd, toRemove, noChange := api.DiffGPJumpItemLists(gpList, stateGPList)
-
-		tflog.Trace(ctx, "🌈 Updating group policy memberships", map[string]interface{}{
-			"add":    toAdd,
-			"remove": toRemove,
-
-			"tf":    tfGPList,
-			"list":  gpList,
-			"state": stateGPList,
-		})
-
-		jgMembershipMutex.Lock()
-		defer jgMembershipMutex.Unlock()
-
-		needsProvision := mapset.NewSet[string]()
-		for m := range toRemove.Iterator().C {
-			m.JumpGroupID = &id
-			tflog.Trace(ctx, "🌈 Deleting item", map[string]interface{}{
-				"add":        m,
-				"gp":         m.GroupPolicyID,
-				"jump group": m.JumpGroupID,
-			})
-			endpoint := fmt.Sprintf("%s/%d", m.Endpoint(), *m.JumpGroupID)
-			err := api.DeleteItemEndpoint[api.GroupPolicyJumpGroup](r.ApiClient, endpoint)
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					"Error updating item's group policy memberships",
-					"Unexpected deleting membership of item ID ["+strconv.Itoa(id)+"]: "+err.Error(),
-				)
-				return
-			}
-			needsProvision.Add(*m.GroupPolicyID)
-		}
-
-		results := noChange.ToSlice()
-		for m := range toAdd.Iterator().C {
-			m.JumpGroupID = &id
-			tflog.Trace(ctx, "🌈 Adding item", map[string]interface{}{
-				"add":         fmt.Sprintf("%+v", m),
-				"gp":          m.GroupPolicyID,
-				"jump group":  m.JumpGroupID,
-				"jump policy": m.JumpPolicyID,
-			})
-			item, err := api.CreateItem(r.ApiClient, m)
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					"Error updating item's group policy memberships",
-					"Unexpected adding membership of item ID ["+strconv.Itoa(id)+"]: "+err.Error(),
-				)
-				return
-			}
-			item.GroupPolicyID = m.GroupPolicyID
-			results = append(results, *item)
-			needsProvision.Add(*m.GroupPolicyID)
+		if tfGPList.IsNull() && tfGPStateList.IsNull() {
+			return
 		}
 
-		for id := range needsProvision.Iter() {
-			p := api.GroupPolicyProvision{
-				GroupPolicyID: &id,
-			}
-			_, err := api.CreateItem(r.ApiClient, p)
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					"Error provisioning item's group policy memberships",
-					"Unexpected response provisioning membership of item ID ["+*p.GroupPolicyID+"]: "+err.Error(),
-				)
-				return
-			}
+		results, err := applyGroupPolicyMemberships[api.GroupPolicyJumpGroup](ctx, r.ApiClient, id, gpList, stateGPList)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating item's group policy memberships",
+				"Unexpected updating memberships of item ID ["+strconv.Itoa(id)+"]: "+err.Error(),
+			)
+			return
 		}
 
-		diags = resp.State.SetAttribute(ctx, path.Root("group_policy_memberships"), results)
+		diags = setGroupPolicyMemberships(ctx, tfGPList, &resp.State, results)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
 			return
@@ -0,0 +1,312 @@
+package rs
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-sra/api"
+	"terraform-provider-sra/bt/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// These throw away variable declarations are to allow the compiler to
+// enforce compliance to these interfaces
+var (
+	_ resource.Resource                = &jumpGroupUserResource{}
+	_ resource.ResourceWithConfigure   = &jumpGroupUserResource{}
+	_ resource.ResourceWithImportState = &jumpGroupUserResource{}
+	_ resource.ResourceWithIdentity    = &jumpGroupUserResource{}
+)
+
+func newJumpGroupUserResource() resource.Resource {
+	return &jumpGroupUserResource{}
+}
+
+type jumpGroupUserResource struct {
+	apiResource[api.JumpGroupUser, models.JumpGroupUser]
+}
+
+const jumpGroupUserSchemaVersion int64 = 1
+
+func (r *jumpGroupUserResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version: jumpGroupUserSchemaVersion,
+		MarkdownDescription: `Manages a direct user membership of a Jump Group.
+
+This grants a single user access to the Jump Group, independent of any group policy memberships. Users that get access through a group policy can't be managed with this resource.
+
+For descriptions of individual fields, please see the Configuration API documentation on your SRA Appliance`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the membership in the form <jump_group_id>/<user_id>",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"jump_group_id": schema.Int64Attribute{
+				Required: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"user_id": schema.Int64Attribute{
+				Required: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"jump_item_role_id": schema.Int64Attribute{
+				Description: `The ID of the Jump Item Role that applies to this membership. Omitting it means "User's Default"`,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"jump_policy_id": schema.Int64Attribute{
+				Description: `The ID of the Jump Policy that applies to this membership. Omitting it means "Set on Jump Items"
+
+This field only applies to PRA`,
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *jumpGroupUserResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(jumpGroupUserSchemaVersion, nil)
+}
+
+func (r *jumpGroupUserResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = compositeIdentitySchema("jump_group_id", "user_id")
+}
+
+func (r *jumpGroupUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan models.JumpGroupUser
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	item := jumpGroupUserFromPlan(&plan)
+	jumpGroupID := *item.JumpGroupID
+	userID := *item.UserID
+
+	tflog.Debug(ctx, "🙀 adding jump group user", map[string]interface{}{
+		"jump group":  jumpGroupID,
+		"user":        userID,
+		"jump policy": item.JumpPolicyID,
+	})
+	newItem, err := api.CreateItem(r.ApiClient, item)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error adding Jump Group user",
+			fmt.Sprintf("Unexpected error adding user [%d] to jump group [%d]: %s", userID, jumpGroupID, err.Error()),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(compositeID(jumpGroupID, userID))
+	setJumpGroupUserComputed(&plan, newItem)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setCompositeIdentity(ctx, resp.Identity, plan.ID, "jump_group_id", "user_id")...)
+}
+
+func (r *jumpGroupUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.JumpGroupUser
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	jumpGroupID, userID, err := parseCompositeID(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading Jump Group user", err.Error())
+		return
+	}
+
+	tmp := api.JumpGroupUser{JumpGroupID: &jumpGroupID}
+	item, err := api.GetItemEndpoint[api.JumpGroupUser](r.ApiClient, fmt.Sprintf("%s/%d", tmp.Endpoint(), userID))
+	if api.IsNotFound(err) {
+		tflog.Warn(ctx, "🙀 membership no longer exists, removing it from state", map[string]interface{}{
+			"id": state.ID.ValueString(),
+		})
+		removeFromState(ctx, resp, setCompositeIdentity(ctx, resp.Identity, state.ID, "jump_group_id", "user_id"))
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Jump Group user",
+			fmt.Sprintf("Unexpected error reading user [%d] of jump group [%d]: %s", userID, jumpGroupID, err.Error()),
+		)
+		return
+	}
+
+	state.JumpGroupID = types.Int64Value(int64(jumpGroupID))
+	state.UserID = types.Int64Value(int64(userID))
+	state.JumpItemRoleID = optionalInt64(item.JumpItemRoleID)
+	state.JumpPolicyID = types.Int64Null()
+	if api.IsPRA() {
+		state.JumpPolicyID = optionalInt64(item.JumpPolicyID)
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setCompositeIdentity(ctx, resp.Identity, state.ID, "jump_group_id", "user_id")...)
+}
+
+func (r *jumpGroupUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan models.JumpGroupUser
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	item := jumpGroupUserFromPlan(&plan)
+	jumpGroupID := *item.JumpGroupID
+	userID := *item.UserID
+
+	newItem, err := api.UpdateItemEndpoint(r.ApiClient, item, fmt.Sprintf("%s/%d", item.Endpoint(), userID))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating Jump Group user",
+			fmt.Sprintf("Unexpected error updating user [%d] of jump group [%d]: %s", userID, jumpGroupID, err.Error()),
+		)
+		return
+	}
+	setJumpGroupUserComputed(&plan, newItem)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setCompositeIdentity(ctx, resp.Identity, plan.ID, "jump_group_id", "user_id")...)
+}
+
+func (r *jumpGroupUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.JumpGroupUser
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	jumpGroupID := int(state.JumpGroupID.ValueInt64())
+	userID := int(state.UserID.ValueInt64())
+	tmp := api.JumpGroupUser{JumpGroupID: &jumpGroupID}
+	err := api.DeleteItemEndpoint[api.JumpGroupUser](r.ApiClient, fmt.Sprintf("%s/%d", tmp.Endpoint(), userID))
+	if api.IsNotFound(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error removing Jump Group user",
+			fmt.Sprintf("Unexpected error removing user [%d] from jump group [%d]: %s", userID, jumpGroupID, err.Error()),
+		)
+		return
+	}
+}
+
+// Imports using an ID in the form <jump_group_id>/<user_id>, or an identity with both IDs
+func (r *jumpGroupUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importID := req.ID
+	if importID == "" && req.Identity != nil {
+		var diags diag.Diagnostics
+		importID, diags = compositeIDFromIdentity(ctx, req.Identity, "jump_group_id", "user_id")
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	jumpGroupID, userID, err := parseCompositeID(importID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), compositeID(jumpGroupID, userID))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("jump_group_id"), int64(jumpGroupID))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), int64(userID))...)
+}
+
+// Builds the API model from the plan. The role and Jump Policy are only sent when they are set, since leaving them
+// out selects the defaults of the appliance
+func jumpGroupUserFromPlan(plan *models.JumpGroupUser) api.JumpGroupUser {
+	jumpGroupID := int(plan.JumpGroupID.ValueInt64())
+	userID := int(plan.UserID.ValueInt64())
+	item := api.JumpGroupUser{
+		JumpGroupID:    &jumpGroupID,
+		UserID:         &userID,
+		JumpItemRoleID: knownInt(plan.JumpItemRoleID),
+	}
+
+	if api.IsPRA() {
+		item.JumpPolicyID = knownInt(plan.JumpPolicyID)
+	} else {
+		plan.JumpPolicyID = types.Int64Null()
+	}
+
+	return item
+}
+
+// Fills in the role and Jump Policy that the appliance picked for the ones left out of the configuration
+func setJumpGroupUserComputed(plan *models.JumpGroupUser, item *api.JumpGroupUser) {
+	if plan.JumpItemRoleID.IsUnknown() {
+		plan.JumpItemRoleID = types.Int64Null()
+		if item != nil {
+			plan.JumpItemRoleID = optionalInt64(item.JumpItemRoleID)
+		}
+	}
+	if plan.JumpPolicyID.IsUnknown() {
+		plan.JumpPolicyID = types.Int64Null()
+		if item != nil && api.IsPRA() {
+			plan.JumpPolicyID = optionalInt64(item.JumpPolicyID)
+		}
+	}
+}
+
+// Returns the value of an optional attribute for the API, or nil when it isn't set
+func knownInt(v types.Int64) *int {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+	i := int(v.ValueInt64())
+	return &i
+}
+
+// Returns an optional ID from the API as an attribute value, which is null when the API left it out
+func optionalInt64(v *int) types.Int64 {
+	if v == nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(*v))
+}
@@ -0,0 +1,72 @@
+package rs
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"terraform-provider-sra/api"
+	"terraform-provider-sra/bt/models"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJumpGroupUserRole(t *testing.T) {
+	ctx := context.Background()
+	var sent map[string]any
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if strings.HasSuffix(r.URL.Path, "oauth2/token") {
+			_, err := w.Write([]byte(`{"token_type":"Bearer","expires_in":3600,"access_token":"secret_access_granted"}`))
+			assert.Nil(t, err)
+			return
+		}
+		body, err := io.ReadAll(r.Body)
+		assert.Nil(t, err)
+		sent = map[string]any{}
+		assert.Nil(t, json.Unmarshal(body, &sent))
+		_, err = w.Write(body)
+		assert.Nil(t, err)
+	}))
+	defer ts.Close()
+
+	clientID := "id"
+	clientSecret := "🤐"
+	c, err := api.NewClient(ts.URL, &clientID, &clientSecret)
+	c.SetTest(t)
+	assert.Nil(t, err)
+
+	r := newJumpGroupUserResource().(*jumpGroupUserResource)
+	r.ApiClient = c
+
+	for _, role := range []types.Int64{types.Int64Unknown(), types.Int64Value(3)} {
+		plan, _ := testState(t, r, map[string]any{
+			"jump_group_id":     int64(2),
+			"user_id":           int64(5),
+			"jump_item_role_id": role,
+			"jump_policy_id":    types.Int64Unknown(),
+		})
+		state, identity := testState(t, r, nil)
+		resp := resource.CreateResponse{State: state, Identity: identity}
+		r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw}}, &resp)
+		assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+		var created models.JumpGroupUser
+		assert.False(t, resp.State.Get(ctx, &created).HasError())
+		if role.IsUnknown() {
+			// Leaving the role out selects the user's default, rather than sending a role that doesn't exist
+			assert.NotContains(t, sent, "jump_item_role_id")
+			assert.True(t, created.JumpItemRoleID.IsNull())
+		} else {
+			assert.Equal(t, float64(3), sent["jump_item_role_id"])
+			assert.Equal(t, int64(3), created.JumpItemRoleID.ValueInt64())
+		}
+		assert.False(t, created.JumpPolicyID.IsUnknown())
+	}
+}
@@ -2,13 +2,10 @@ package rs
 
 import (
 	"context"
-	"fmt"
 	"strconv"
-	"sync"
 	"terraform-provider-sra/api"
 	"terraform-provider-sra/bt/models"
 
-	mapset "github.com/deckarep/golang-set/v2"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -28,11 +25,6 @@ var (
 	_ resource.ResourceWithConfigure   = &jumpointResource{}
 	_ resource.ResourceWithImportState = &jumpointResource{}
 	_ resource.ResourceWithModifyPlan  = &jumpointResource{}
-
-	// Because of the way the PHP code handles changing memberships, those
-	// operations cannot be done in parallel. We use this mutex to ensure
-	// we deal with membership updates one at a time
-	jpMembershipMutex sync.Mutex
 )
 
 func newJumpointResource() resource.Resource {
@@ -43,8 +35,11 @@ type jumpointResource struct {
 	apiResource[api.Jumpoint, models.Jumpoint]
 }
 
+const jumpointSchemaVersion int64 = 1
+
 func (r *jumpointResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:     jumpointSchemaVersion,
 		Description: "Manages a Jump Group.\n\nFor descriptions of individual fields, please see the Configuration API documentation on your SRA Appliance",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -53,6 +48,8 @@ func (r *jumpointResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"adopt_existing":      adoptExistingSchema(),
+			"deletion_protection": deletionProtectionSchema(),
 			"name": schema.StringAttribute{
 				Required: true,
 			},
@@ -100,6 +97,7 @@ func (r *jumpointResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				Description: "This field only applies to PRA",
 			},
 
+			"group_policy_membership_mode": groupPolicyMembershipModeSchema(),
 			"group_policy_memberships": schema.SetNestedAttribute{
 				Optional: true,
 				NestedObject: schema.NestedAttributeObject{
@@ -115,12 +113,18 @@ func (r *jumpointResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 	}
 }
 
+func (r *jumpointResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(jumpointSchemaVersion, nil)
+}
+
 func (r *jumpointResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	tflog.Debug(ctx, "Starting plan modification")
+	warnDeletionProtection(ctx, req, resp, r.printableName())
 	if req.Plan.Raw.IsNull() {
 		tflog.Debug(ctx, "No plan to modify")
 		return
 	}
+	checkInlineMemberships(ctx, req.Plan, "jumpoint_id", "group_policy_jumpoint", &resp.Diagnostics)
 	var plan models.Jumpoint
 	diags := req.Plan.Get(ctx, &plan)
 	tflog.Debug(ctx, "Read plan")
@@ -173,50 +177,13 @@ func (r *jumpointResource) Create(ctx context.Context, req resource.CreateReques
 			return
 		}
 
-		toAdd := mapset.NewSet(gpList...)
-
-		tflog.Trace(ctx, "🌈 Updating group policy memberships", map[string]interface{}{
-			"add": toAdd,
-
-			"tf":   tfGPList,
-			"list": gpList,
-		})
-
-		jpMembershipMutex.Lock()
-		defer jpMembershipMutex.Unlock()
-
-		results := []api.GroupPolicyJumpoint{}
-		needsProvision := mapset.NewSet[string]()
-		for m := range toAdd.Iterator().C {
-			m.JumpointID = &id
-			item, err := api.CreateItem(r.ApiClient, m)
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					"Error adding item's group policy memberships",
-					"Unexpected adding membership of item ID ["+strconv.Itoa(id)+"]: "+err.Error(),
-				)
-				return
-			}
-
-			item.GroupPolicyID = m.GroupPolicyID
-			results = append(results, *item)
-			needsProvision.Add(*m.GroupPolicyID)
-		}
-
-		for id := range needsProvision.Iter() {
-			p := api.GroupPolicyProvision{
-				GroupPolicyID: &id,
-			}
-			_, err := api.CreateItem(r.ApiClient, p)
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					"Error provisioning item's group policy memberships",
-					"Unexpected response provisioning membership of item ID ["+*p.GroupPolicyID+"]: "+err.Error(),
-				)
-				return
-			}
+		results, err := applyGroupPolicyMemberships[api.GroupPolicyJumpoint](ctx, r.ApiClient, id, gpList, nil)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error adding item's group policy memberships",
+				"Unexpected adding memberships of item ID ["+strconv.Itoa(id)+"]: "+err.Error(),
+			)
+			return
 		}
 
 		diags = resp.State.SetAttribute(ctx, path.Root("group_policy_memberships"), results)
@@ -231,7 +198,7 @@ func (r *jumpointResource) Create(ctx context.Context, req resource.CreateReques
 
 func (r *jumpointResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	r.apiResource.Read(ctx, req, resp)
-	if resp.Diagnostics.HasError() {
+	if resp.Diagnostics.HasError() || resourceGone(resp) {
 		return
 	}
 
@@ -239,57 +206,7 @@ func (r *jumpointResource) Read(ctx context.Context, req resource.ReadRequest, r
 	req.State.GetAttribute(ctx, path.Root("id"), &tfId)
 	id, _ := strconv.Atoi(tfId.ValueString())
 
-	{
-		// Group Policy Memberships
-
-		var tfGPList types.Set
-		diags := req.State.GetAttribute(ctx, path.Root("group_policy_memberships"), &tfGPList)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-
-		if tfGPList.IsNull() {
-			return
-		}
-
-		var gpList []api.GroupPolicyJumpoint
-		diags = tfGPList.ElementsAs(ctx, &gpList, false)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-
-		for i, m := range gpList {
-			m.JumpointID = &id
-			tflog.Trace(ctx, "🌈 Reading item", map[string]interface{}{
-				"read": m,
-			})
-			gpId := *m.GroupPolicyID
-
-			endpoint := fmt.Sprintf("%s/%d", m.Endpoint(), id)
-			item, err := api.GetItemEndpoint[api.GroupPolicyJumpoint](r.ApiClient, endpoint)
-
-			if err != nil {
-				tflog.Trace(ctx, "🌈 Error reading item item, skipping", map[string]interface{}{
-					"read":  m,
-					"error": err,
-				})
-			} else if item != nil {
-				tflog.Trace(ctx, "🌈 Read item", map[string]interface{}{
-					"read": *item,
-				})
-				item.GroupPolicyID = &gpId
-				gpList[i] = *item
-			}
-		}
-
-		diags = resp.State.SetAttribute(ctx, path.Root("group_policy_memberships"), gpList)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-	}
+	readGroupPolicyMemberships[api.GroupPolicyJumpoint](ctx, r.ApiClient, req.State, resp, id)
 }
 
 func (r *jumpointResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -312,13 +229,12 @@ func (r *jumpointResource) Update(ctx context.Context, req resource.UpdateReques
 		}
 
 		var gpList []api.GroupPolicyJumpoint
-		if tfGPList.IsNull() {
-			return
-		}
-		diags = tfGPList.ElementsAs(ctx, &gpList, false)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
+		if !tfGPList.IsNull() {
+			diags = tfGPList.ElementsAs(ctx, &gpList, false)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
 		}
 
 		var tfGPStateList types.Set
@@ -337,75 +253,20 @@ func (r *jumpointResource) Update(ctx context.Context, req resource.UpdateReques
 			}
 		}
 
-		toAdd, toRemove, noChange := api.DiffGPJumpointLists(gpList, stateGPList)
-
-		tflog.Trace(ctx, "🌈 Updating group policy memberships", map[string]interface{}{
-			"add":    toAdd,
-			"remove": toRemove,
-
-			"tf":    tfGPList,
-			"list":  gpList,
-			"state": stateGPList,
-		})
-
-		jpMembershipMutex.Lock()
-		defer jpMembershipMutex.Unlock()
-
-		needsProvision := mapset.NewSet[string]()
-		for m := range toRemove.Iterator().C {
-			m.JumpointID = &id
-			tflog.Trace(ctx, "🌈 Deleting item", map[string]interface{}{
-				"add":      m,
-				"gp":       m.GroupPolicyID,
-				"jumpoint": m.JumpointID,
-			})
-			endpoint := fmt.Sprintf("%s/%d", m.Endpoint(), *m.JumpointID)
-			err := api.DeleteItemEndpoint[api.GroupPolicyJumpoint](r.ApiClient, endpoint)
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					"Error updating item's group policy memberships",
-					"Unexpected deleting membership of item ID ["+strconv.Itoa(id)+"]: "+err.Error(),
-				)
-				return
-			}
-			needsProvision.Add(*m.GroupPolicyID)
-		}
-
-		results := noChange.ToSlice()
-		for m := range toAdd.Iterator().C {
-			m.JumpointID = &id
-			item, err := api.CreateItem(r.ApiClient, m)
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					"Error updating item's group policy memberships",
-					"Unexpected adding membership of item ID ["+strconv.Itoa(id)+"]: "+err.Error(),
-				)
-				return
-			}
-
-			item.GroupPolicyID = m.GroupPolicyID
-			results = append(results, *item)
-			needsProvision.Add(*m.GroupPolicyID)
+		if tfGPList.IsNull() && tfGPStateList.IsNull() {
+			return
 		}
 
-		for id := range needsProvision.Iter() {
-			p := api.GroupPolicyProvision{
-				GroupPolicyID: &id,
-			}
-			_, err := api.CreateItem(r.ApiClient, p)
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					"Error provisioning item's group policy memberships",
-					"Unexpected response provisioning membership of item ID ["+*p.GroupPolicyID+"]: "+err.Error(),
-				)
-				return
-			}
+		results, err := applyGroupPolicyMemberships[api.GroupPolicyJumpoint](ctx, r.ApiClient, id, gpList, stateGPList)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating item's group policy memberships",
+				"Unexpected updating memberships of item ID ["+strconv.Itoa(id)+"]: "+err.Error(),
+			)
+			return
 		}
 
-		diags = resp.State.SetAttribute(ctx, path.Root("group_policy_memberships"), results)
+		diags = setGroupPolicyMemberships(ctx, tfGPList, &resp.State, results)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
 			return
@@ -0,0 +1,224 @@
+package rs
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-sra/api"
+	"terraform-provider-sra/bt/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// These throw away variable declarations are to allow the compiler to
+// enforce compliance to these interfaces
+var (
+	_ resource.Resource                = &jumpointUserResource{}
+	_ resource.ResourceWithConfigure   = &jumpointUserResource{}
+	_ resource.ResourceWithImportState = &jumpointUserResource{}
+	_ resource.ResourceWithIdentity    = &jumpointUserResource{}
+)
+
+func newJumpointUserResource() resource.Resource {
+	return &jumpointUserResource{}
+}
+
+type jumpointUserResource struct {
+	apiResource[api.JumpointUser, models.JumpointUser]
+}
+
+const jumpointUserSchemaVersion int64 = 1
+
+func (r *jumpointUserResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version: jumpointUserSchemaVersion,
+		MarkdownDescription: `Manages a direct user membership of a Jumpoint.
+
+This grants a single user access to the Jumpoint, independent of any group policy memberships. Users that get access through a group policy can't be managed with this resource.
+
+For descriptions of individual fields, please see the Configuration API documentation on your SRA Appliance`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the membership in the form <jumpoint_id>/<user_id>",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"jumpoint_id": schema.Int64Attribute{
+				Required: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"user_id": schema.Int64Attribute{
+				Required: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *jumpointUserResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(jumpointUserSchemaVersion, nil)
+}
+
+func (r *jumpointUserResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = compositeIdentitySchema("jumpoint_id", "user_id")
+}
+
+func (r *jumpointUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan models.JumpointUser
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	jumpointID := int(plan.JumpointID.ValueInt64())
+	userID := int(plan.UserID.ValueInt64())
+	item := api.JumpointUser{
+		JumpointID: &jumpointID,
+		UserID:     &userID,
+	}
+
+	tflog.Debug(ctx, "🙀 adding jumpoint user", map[string]interface{}{
+		"jumpoint": jumpointID,
+		"user":     userID,
+	})
+	_, err := api.CreateItem(r.ApiClient, item)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error adding Jumpoint user",
+			fmt.Sprintf("Unexpected error adding user [%d] to jumpoint [%d]: %s", userID, jumpointID, err.Error()),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(compositeID(jumpointID, userID))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setCompositeIdentity(ctx, resp.Identity, plan.ID, "jumpoint_id", "user_id")...)
+}
+
+func (r *jumpointUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.JumpointUser
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	jumpointID, userID, err := parseCompositeID(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading Jumpoint user", err.Error())
+		return
+	}
+
+	tmp := api.JumpointUser{JumpointID: &jumpointID}
+	_, err = api.GetItemEndpoint[api.JumpointUser](r.ApiClient, fmt.Sprintf("%s/%d", tmp.Endpoint(), userID))
+	if api.IsNotFound(err) {
+		tflog.Warn(ctx, "🙀 membership no longer exists, removing it from state", map[string]interface{}{
+			"id": state.ID.ValueString(),
+		})
+		removeFromState(ctx, resp, setCompositeIdentity(ctx, resp.Identity, state.ID, "jumpoint_id", "user_id"))
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Jumpoint user",
+			fmt.Sprintf("Unexpected error reading user [%d] of jumpoint [%d]: %s", userID, jumpointID, err.Error()),
+		)
+		return
+	}
+
+	state.JumpointID = types.Int64Value(int64(jumpointID))
+	state.UserID = types.Int64Value(int64(userID))
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setCompositeIdentity(ctx, resp.Identity, state.ID, "jumpoint_id", "user_id")...)
+}
+
+// Every attribute requires replacement, so there is nothing to send to the API here
+func (r *jumpointUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan models.JumpointUser
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setCompositeIdentity(ctx, resp.Identity, plan.ID, "jumpoint_id", "user_id")...)
+}
+
+func (r *jumpointUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.JumpointUser
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	jumpointID := int(state.JumpointID.ValueInt64())
+	userID := int(state.UserID.ValueInt64())
+	tmp := api.JumpointUser{JumpointID: &jumpointID}
+	err := api.DeleteItemEndpoint[api.JumpointUser](r.ApiClient, fmt.Sprintf("%s/%d", tmp.Endpoint(), userID))
+	if api.IsNotFound(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error removing Jumpoint user",
+			fmt.Sprintf("Unexpected error removing user [%d] from jumpoint [%d]: %s", userID, jumpointID, err.Error()),
+		)
+		return
+	}
+}
+
+// Imports using an ID in the form <jumpoint_id>/<user_id>, or an identity with both IDs
+func (r *jumpointUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importID := req.ID
+	if importID == "" && req.Identity != nil {
+		var diags diag.Diagnostics
+		importID, diags = compositeIDFromIdentity(ctx, req.Identity, "jumpoint_id", "user_id")
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	jumpointID, userID, err := parseCompositeID(importID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), compositeID(jumpointID, userID))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("jumpoint_id"), int64(jumpointID))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), int64(userID))...)
+}
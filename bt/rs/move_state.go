@@ -0,0 +1,81 @@
+package rs
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"terraform-provider-sra/bt/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+/*
+Moving state between resource types. Some Jump Items can be managed by more than one resource type, such as
+a PostgreSQL tunnel created with sra_protocol_tunnel_jump before sra_postgresql_tunnel_jump existed. The item
+on the appliance is the same either way, so a moved block only needs the state copied across to the new type.
+*/
+
+const (
+	protocolTunnelJumpTypeName = "sra_protocol_tunnel_jump"
+
+	// The registry address of this provider, as served in main.go. Only state from this provider is moved.
+	providerAddress = "registry.terraform.io/beyondtrust/sra"
+)
+
+// Returns a StateMover that accepts sra_protocol_tunnel_jump state for items with the given tunnel_type
+func protocolTunnelStateMover[TTf any](ctx context.Context, tunnelType string) resource.StateMover {
+	var sourceSchema resource.SchemaResponse
+	newProtocolTunnelJumpResource().Schema(ctx, resource.SchemaRequest{}, &sourceSchema)
+
+	return resource.StateMover{
+		SourceSchema: &sourceSchema.Schema,
+		StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+			if req.SourceProviderAddress != providerAddress || req.SourceTypeName != protocolTunnelJumpTypeName {
+				return
+			}
+			if req.SourceState == nil {
+				resp.Diagnostics.AddError(
+					"Unable to move state",
+					fmt.Sprintf("The %s state could not be read. Upgrade the provider that wrote it before moving it.", protocolTunnelJumpTypeName),
+				)
+				return
+			}
+
+			var source models.ProtocolTunnelJump
+			resp.Diagnostics.Append(req.SourceState.Get(ctx, &source)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			if source.TunnelType.ValueString() != tunnelType {
+				resp.Diagnostics.AddError(
+					"Unable to move state",
+					fmt.Sprintf("Only %s items with tunnel_type \"%s\" can be moved to this resource, but item [%s] has tunnel_type \"%s\".", protocolTunnelJumpTypeName, tunnelType, source.ID.ValueString(), source.TunnelType.ValueString()),
+				)
+				return
+			}
+
+			var target TTf
+			copyMatchingFields(&target, &source)
+
+			resp.Diagnostics.Append(resp.TargetState.Set(ctx, &target)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			resp.Diagnostics.Append(setIdentityID(ctx, resp.TargetIdentity, source.ID)...)
+		},
+	}
+}
+
+// Copies the fields of src to the fields of dst with the same name and type. Fields only in dst are left null.
+func copyMatchingFields(dst any, src any) {
+	dstObj := reflect.ValueOf(dst).Elem()
+	srcObj := reflect.ValueOf(src).Elem()
+	for i := 0; i < dstObj.NumField(); i++ {
+		srcField := srcObj.FieldByName(dstObj.Type().Field(i).Name)
+		if srcField.IsValid() && srcField.Type() == dstObj.Field(i).Type() {
+			dstObj.Field(i).Set(srcField)
+		}
+	}
+}
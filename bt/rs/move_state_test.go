@@ -0,0 +1,77 @@
+package rs
+
+import (
+	"context"
+	"testing"
+
+	"terraform-provider-sra/bt/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProtocolTunnelStateMover(t *testing.T) {
+	ctx := context.Background()
+	r := newPostgreSQLTunnelJumpResource().(*postgresqlTunnelJumpResource)
+	movers := r.MoveState(ctx)
+	assert.Len(t, movers, 1)
+	mover := movers[0]
+
+	var targetSchema resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &targetSchema)
+	var identitySchema resource.IdentitySchemaResponse
+	r.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identitySchema)
+
+	move := func(providerAddress string, typeName string, tunnelType string) resource.MoveStateResponse {
+		source := tfsdk.State{Schema: *mover.SourceSchema, Raw: tftypes.NewValue(mover.SourceSchema.Type().TerraformType(ctx), nil)}
+		source.SetAttribute(ctx, path.Root("id"), "12")
+		source.SetAttribute(ctx, path.Root("name"), "Database")
+		source.SetAttribute(ctx, path.Root("hostname"), "db.example.com")
+		source.SetAttribute(ctx, path.Root("jumpoint_id"), int64(1))
+		source.SetAttribute(ctx, path.Root("jump_group_id"), int64(2))
+		source.SetAttribute(ctx, path.Root("username"), "postgres")
+		source.SetAttribute(ctx, path.Root("tunnel_type"), tunnelType)
+
+		resp := resource.MoveStateResponse{
+			TargetState: tfsdk.State{Schema: targetSchema.Schema, Raw: tftypes.NewValue(targetSchema.Schema.Type().TerraformType(ctx), nil)},
+			TargetIdentity: &tfsdk.ResourceIdentity{
+				Schema: identitySchema.IdentitySchema,
+				Raw:    tftypes.NewValue(identitySchema.IdentitySchema.Type().TerraformType(ctx), nil),
+			},
+		}
+		mover.StateMover(ctx, resource.MoveStateRequest{SourceProviderAddress: providerAddress, SourceTypeName: typeName, SourceState: &source}, &resp)
+		return resp
+	}
+
+	resp := move("registry.terraform.io/beyondtrust/sra", "sra_protocol_tunnel_jump", "psql")
+	assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	var target models.PostgreSQLTunnelJump
+	assert.False(t, resp.TargetState.Get(ctx, &target).HasError())
+	assert.Equal(t, "12", target.ID.ValueString())
+	assert.Equal(t, "Database", target.Name.ValueString())
+	assert.Equal(t, "db.example.com", target.Hostname.ValueString())
+	assert.Equal(t, int64(2), target.JumpGroupID.ValueInt64())
+	assert.Equal(t, "postgres", target.Username.ValueString())
+	var id types.String
+	resp.TargetIdentity.GetAttribute(ctx, path.Root("id"), &id)
+	assert.Equal(t, "12", id.ValueString())
+
+	// Items of other tunnel types don't belong in this resource
+	resp = move("registry.terraform.io/beyondtrust/sra", "sra_protocol_tunnel_jump", "tcp")
+	assert.True(t, resp.Diagnostics.HasError())
+	assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), `tunnel_type "tcp"`)
+
+	// Other source types are left for other movers
+	resp = move("registry.terraform.io/beyondtrust/sra", "sra_shell_jump", "psql")
+	assert.False(t, resp.Diagnostics.HasError())
+	assert.True(t, resp.TargetState.Raw.IsNull())
+
+	// So is state of the same type from another provider
+	resp = move("registry.terraform.io/example/sra", "sra_protocol_tunnel_jump", "psql")
+	assert.False(t, resp.Diagnostics.HasError())
+	assert.True(t, resp.TargetState.Raw.IsNull())
+}
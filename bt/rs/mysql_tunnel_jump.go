@@ -20,6 +20,7 @@ var (
 	_ resource.Resource                = &mysqlTunnelJumpResource{}
 	_ resource.ResourceWithConfigure   = &mysqlTunnelJumpResource{}
 	_ resource.ResourceWithImportState = &mysqlTunnelJumpResource{}
+	_ resource.ResourceWithMoveState   = &mysqlTunnelJumpResource{}
 )
 
 func newMySQLTunnelJumpResource() resource.Resource { return &mysqlTunnelJumpResource{} }
@@ -28,6 +29,13 @@ type mysqlTunnelJumpResource struct {
 	apiResource[api.MySQLTunnelJump, models.MySQLTunnelJump]
 }
 
+// MySQL tunnels created with sra_protocol_tunnel_jump can be moved to this resource without re-creating them
+func (r *mysqlTunnelJumpResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		protocolTunnelStateMover[models.MySQLTunnelJump](ctx, "mysql"),
+	}
+}
+
 func (r *mysqlTunnelJumpResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	tflog.Debug(ctx, "Starting plan modification (mysql)")
 	if req.Plan.Raw.IsNull() {
@@ -109,11 +117,16 @@ func applyMySQLDefaultsAndValidate(plan *models.MySQLTunnelJump) diag.Diagnostic
 	return diags
 }
 
+const mysqlTunnelJumpSchemaVersion int64 = 1
+
 func (r *mysqlTunnelJumpResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:     mysqlTunnelJumpSchemaVersion,
 		Description: "Manages a MySQL Tunnel Jump Item. NOTE: PRA only.",
 		Attributes: map[string]schema.Attribute{
 			"id":                    schema.StringAttribute{Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+			"copy_from_id":          copyFromIDSchema(),
+			"adopt_existing":        adoptExistingSchema(),
 			"name":                  schema.StringAttribute{Required: true},
 			"jumpoint_id":           schema.Int64Attribute{Required: true},
 			"hostname":              schema.StringAttribute{Required: true},
@@ -129,3 +142,7 @@ func (r *mysqlTunnelJumpResource) Schema(_ context.Context, _ resource.SchemaReq
 		},
 	}
 }
+
+func (r *mysqlTunnelJumpResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(mysqlTunnelJumpSchemaVersion, nil)
+}
@@ -5,7 +5,6 @@ import (
 	"terraform-provider-sra/api"
 	"terraform-provider-sra/bt/models"
 
-	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -13,320 +12,152 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
 func applyNetworkTunnelValidate(ctx context.Context, plan *models.NetworkTunnelJump) diag.Diagnostics {
+	return ValidateFilterRules(ctx, plan.FilterRules)
+}
+
+// ValidateFilterRules checks the filter_rules of a Network Tunnel Jump Item. Rules are read through their
+// attributes rather than decoded into structs, so both the full schema type and partial object types work.
+// Unknown values are skipped, since they can't be checked until apply.
+func ValidateFilterRules(ctx context.Context, filterRules types.List) diag.Diagnostics {
 	var diags diag.Diagnostics
-	// filter_rules is now a structured list attribute in the TF model.
-	if plan.FilterRules.IsNull() || plan.FilterRules.IsUnknown() {
-		diags.Append(diag.NewErrorDiagnostic("filter_rules is required", "NetworkTunnelJump requires filter_rules with at least one rule"))
+	if filterRules.IsNull() || filterRules.IsUnknown() {
+		diags.AddError("filter_rules is required", "NetworkTunnelJump requires filter_rules with at least one rule")
+		return diags
+	}
+
+	rules := filterRules.Elements()
+	if len(rules) == 0 {
+		diags.AddError("filter_rules is required", "NetworkTunnelJump requires at least one filter rule")
 		return diags
 	}
+	if len(rules) > 50 {
+		diags.AddError("filter_rules too large", "NetworkTunnelJump filter_rules must contain at most 50 rules")
+		return diags
+	}
+
+	for _, rule := range rules {
+		obj, ok := rule.(types.Object)
+		if !ok {
+			diags.AddError("filter_rules invalid", "unable to parse filter_rules object")
+			return diags
+		}
+		if obj.IsUnknown() {
+			continue
+		}
 
-	// Try decoding into TF runtime objects then convert each to a generic map.
-	var rules []map[string]interface{}
-	var objValues []types.Object
-	if err := plan.FilterRules.ElementsAs(ctx, &objValues, false); err == nil {
-		// basic checks
-		if len(objValues) == 0 {
-			diags.Append(diag.NewErrorDiagnostic("filter_rules is required", "NetworkTunnelJump requires at least one filter rule"))
+		attrs := obj.Attributes()
+		ipAddresses, ok := attrs["ip_addresses"].(types.Object)
+		if !ok || ipAddresses.IsNull() {
+			diags.AddError("filter_rules invalid", "each filter_rules entry must include ip_addresses")
 			return diags
 		}
-		if len(objValues) > 50 {
-			diags.Append(diag.NewErrorDiagnostic("filter_rules too large", "NetworkTunnelJump filter_rules must contain at most 50 rules"))
+		diags.Append(validateFilterRuleIPAddresses(ctx, ipAddresses)...)
+		if diags.HasError() {
 			return diags
 		}
 
-		for _, obj := range objValues {
-			// 1) Try decoding into a struct that includes Ports
-			var withPorts struct {
-				IpAddresses types.Object `tfsdk:"ip_addresses"`
-				Ports       types.Object `tfsdk:"ports"`
-			}
-			if d := obj.As(ctx, &withPorts, basetypes.ObjectAsOptions{UnhandledNullAsEmpty: true, UnhandledUnknownAsEmpty: true}); !d.HasError() {
-				// ip_addresses must be present and be an object with one of cidr/list/range
-				if withPorts.IpAddresses.IsNull() || withPorts.IpAddresses.IsUnknown() {
-					diags.Append(diag.NewErrorDiagnostic("filter_rules invalid", "each filter_rules entry must include ip_addresses"))
-					return diags
-				}
-				m := make(map[string]interface{})
-				m["ip_addresses"] = withPorts.IpAddresses
-				if !withPorts.Ports.IsNull() && !withPorts.Ports.IsUnknown() {
-					// Try decoding as list-only
-					var listOnly struct {
-						List types.List `tfsdk:"list"`
-					}
-					if ld := withPorts.Ports.As(ctx, &listOnly, basetypes.ObjectAsOptions{UnhandledNullAsEmpty: true, UnhandledUnknownAsEmpty: true}); !ld.HasError() {
-						if !(listOnly.List.IsNull() || listOnly.List.IsUnknown()) {
-							var portVals []int64
-							if err := listOnly.List.ElementsAs(ctx, &portVals, false); err != nil || len(portVals) == 0 {
-								diags.Append(diag.NewErrorDiagnostic("filter_rules invalid", "ports.list must be a non-empty list of port numbers"))
-								return diags
-							}
-							for _, p := range portVals {
-								if p < 1 || p > 65535 {
-									diags.Append(diag.NewErrorDiagnostic("filter_rules invalid", "port values must be between 1 and 65535"))
-									return diags
-								}
-							}
-						}
-						m["ports"] = withPorts.Ports
-					} else {
-						// Try decoding as range-only
-						var rangeOnly struct {
-							Range struct {
-								Start types.Int64 `tfsdk:"start"`
-								End   types.Int64 `tfsdk:"end"`
-							} `tfsdk:"range"`
-						}
-						if rd := withPorts.Ports.As(ctx, &rangeOnly, basetypes.ObjectAsOptions{UnhandledNullAsEmpty: true, UnhandledUnknownAsEmpty: true}); !rd.HasError() {
-							if !(rangeOnly.Range.Start.IsNull() || rangeOnly.Range.Start.IsUnknown() || rangeOnly.Range.End.IsNull() || rangeOnly.Range.End.IsUnknown()) {
-								start := rangeOnly.Range.Start.ValueInt64()
-								end := rangeOnly.Range.End.ValueInt64()
-								if start < 1 || end < 1 || start > 65535 || end > 65535 {
-									diags.Append(diag.NewErrorDiagnostic("filter_rules invalid", "range start/end must be between 1 and 65535"))
-									return diags
-								}
-								if start > end {
-									diags.Append(diag.NewErrorDiagnostic("filter_rules invalid", "range start must be <= range end"))
-									return diags
-								}
-							}
-							m["ports"] = withPorts.Ports
-						} else {
-							diags.Append(diag.NewErrorDiagnostic("filter_rules invalid", "unable to parse ports object 4"))
-							return diags
-						}
-					}
-				}
-				rules = append(rules, m)
-				continue
+		if ports, ok := attrs["ports"].(types.Object); ok {
+			diags.Append(validateFilterRulePorts(ctx, ports)...)
+			if diags.HasError() {
+				return diags
 			}
+		}
+	}
 
-			// 2) Try decoding into a struct with only ip_addresses (works when ports absent)
-			var noPorts struct {
-				IpAddresses types.Object `tfsdk:"ip_addresses"`
-			}
-			if d := obj.As(ctx, &noPorts, basetypes.ObjectAsOptions{UnhandledNullAsEmpty: true, UnhandledUnknownAsEmpty: true}); !d.HasError() {
-				if noPorts.IpAddresses.IsNull() || noPorts.IpAddresses.IsUnknown() {
-					diags.Append(diag.NewErrorDiagnostic("filter_rules invalid", "each filter_rules entry must include ip_addresses"))
-					return diags
-				}
-				m := make(map[string]interface{})
-				m["ip_addresses"] = noPorts.IpAddresses
-				rules = append(rules, m)
-				continue
-			}
+	return diags
+}
 
-			// 3) Fallback: decode into map[string]attr.Value and preserve attr.Values
-			var mdAttr map[string]attr.Value
-			if d := obj.As(ctx, &mdAttr, basetypes.ObjectAsOptions{UnhandledNullAsEmpty: true, UnhandledUnknownAsEmpty: true}); d.HasError() {
-				diags.Append(diag.NewErrorDiagnostic("filter_rules invalid", "unable to parse filter_rules object"))
-				return diags
-			}
-			m := make(map[string]interface{})
-			if v, ok := mdAttr["ip_addresses"]; ok {
-				m["ip_addresses"] = v
-			}
-			if v, ok := mdAttr["ports"]; ok {
-				// try to validate ports when present in the fallback path
-				if pvObj, ok := v.(types.Object); ok {
-					var portsStruct struct {
-						List  types.List `tfsdk:"list"`
-						Range struct {
-							Start types.Int64 `tfsdk:"start"`
-							End   types.Int64 `tfsdk:"end"`
-						} `tfsdk:"range"`
-					}
-					if pd := pvObj.As(ctx, &portsStruct, basetypes.ObjectAsOptions{UnhandledNullAsEmpty: true, UnhandledUnknownAsEmpty: true}); pd.HasError() {
-						diags.Append(diag.NewErrorDiagnostic("filter_rules invalid", "unable to parse ports object 1"))
-						return diags
-					}
-					// reuse same checks as above
-					listPresent := !(portsStruct.List.IsNull() || portsStruct.List.IsUnknown())
-					rangePresent := !(portsStruct.Range.Start.IsNull() || portsStruct.Range.Start.IsUnknown() || portsStruct.Range.End.IsNull() || portsStruct.Range.End.IsUnknown())
-					if listPresent && rangePresent {
-						diags.Append(diag.NewErrorDiagnostic("filter_rules invalid", "ports must contain either 'list' or 'range', not both"))
-						return diags
-					}
-				}
-				m["ports"] = v
-			}
-			rules = append(rules, m)
+// ip_addresses must have one of a non-empty cidr, a non-empty list of addresses or a range with a start and end
+func validateFilterRuleIPAddresses(ctx context.Context, ipAddresses types.Object) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if ipAddresses.IsUnknown() {
+		return diags
+	}
+
+	attrs := ipAddresses.Attributes()
+	if cidr, ok := attrs["cidr"].(types.String); ok && !cidr.IsNull() {
+		if !cidr.IsUnknown() && cidr.ValueString() == "" {
+			diags.AddError("filter_rules invalid", "ip_addresses.cidr must be a non-empty string")
 		}
+		return diags
 	}
-	for _, r := range rules {
-		v, ok := r["ip_addresses"]
-		if !ok {
-			diags.Append(diag.NewErrorDiagnostic("filter_rules invalid", "each filter_rules entry must include ip_addresses"))
+	if list, ok := attrs["list"].(types.List); ok && !list.IsNull() {
+		if list.IsUnknown() {
 			return diags
 		}
-		// ip_addresses should be a nested object. Accept objects with either
-		// a 'cidr' string, a 'range' object {start,end}, or a 'list' of strings.
-		switch vv := v.(type) {
-		case attr.Value:
-			// Expect types.Object
-			if obj, ok := vv.(types.Object); ok {
-				// try cidr
-				var cidrOnly struct {
-					Cidr types.String `tfsdk:"cidr"`
-				}
-				if d := obj.As(ctx, &cidrOnly, basetypes.ObjectAsOptions{UnhandledNullAsEmpty: true, UnhandledUnknownAsEmpty: true}); !d.HasError() {
-					if cidrOnly.Cidr.IsNull() || cidrOnly.Cidr.IsUnknown() || cidrOnly.Cidr.ValueString() == "" {
-						diags.Append(diag.NewErrorDiagnostic("filter_rules invalid", "ip_addresses.cidr must be a non-empty string"))
-						return diags
-					}
-					break
-				}
-				// try list
-				var listOnly struct {
-					List types.List `tfsdk:"list"`
-				}
-				if d := obj.As(ctx, &listOnly, basetypes.ObjectAsOptions{UnhandledNullAsEmpty: true, UnhandledUnknownAsEmpty: true}); !d.HasError() {
-					if listOnly.List.IsNull() || listOnly.List.IsUnknown() {
-						diags.Append(diag.NewErrorDiagnostic("filter_rules invalid", "ip_addresses.list must be provided or other ip_addresses variant"))
-						return diags
-					}
-					var ips []string
-					if err := listOnly.List.ElementsAs(ctx, &ips, false); err != nil || len(ips) == 0 {
-						diags.Append(diag.NewErrorDiagnostic("filter_rules invalid", "ip_addresses.list must be a non-empty list of strings"))
-						return diags
-					}
-					break
-				}
-				// try range
-				var rangeOnly struct {
-					Range struct {
-						Start types.String `tfsdk:"start"`
-						End   types.String `tfsdk:"end"`
-					} `tfsdk:"range"`
-				}
-				if d := obj.As(ctx, &rangeOnly, basetypes.ObjectAsOptions{UnhandledNullAsEmpty: true, UnhandledUnknownAsEmpty: true}); !d.HasError() {
-					if rangeOnly.Range.Start.IsNull() || rangeOnly.Range.Start.IsUnknown() || rangeOnly.Range.End.IsNull() || rangeOnly.Range.End.IsUnknown() {
-						diags.Append(diag.NewErrorDiagnostic("filter_rules invalid", "ip_addresses.range must include start and end"))
-						return diags
-					}
-					// additional validation of IP format could be added here
-					break
-				}
-				diags.Append(diag.NewErrorDiagnostic("filter_rules invalid", "ip_addresses must contain one of: cidr, range, or list"))
-				return diags
-			}
-			diags.Append(diag.NewErrorDiagnostic("filter_rules invalid", "ip_addresses must be an object"))
-			return diags
-		case types.Object:
-			// same handling as attr.Value case above
-			var obj types.Object = vv
-			var cidrOnly struct {
-				Cidr types.String `tfsdk:"cidr"`
-			}
-			if d := obj.As(ctx, &cidrOnly, basetypes.ObjectAsOptions{UnhandledNullAsEmpty: true, UnhandledUnknownAsEmpty: true}); !d.HasError() {
-				if cidrOnly.Cidr.IsNull() || cidrOnly.Cidr.IsUnknown() || cidrOnly.Cidr.ValueString() == "" {
-					diags.Append(diag.NewErrorDiagnostic("filter_rules invalid", "ip_addresses.cidr must be a non-empty string"))
-					return diags
-				}
-				break
-			}
-			var listOnly struct {
-				List types.List `tfsdk:"list"`
-			}
-			if d := obj.As(ctx, &listOnly, basetypes.ObjectAsOptions{UnhandledNullAsEmpty: true, UnhandledUnknownAsEmpty: true}); !d.HasError() {
-				if listOnly.List.IsNull() || listOnly.List.IsUnknown() {
-					diags.Append(diag.NewErrorDiagnostic("filter_rules invalid", "ip_addresses.list must be provided or other ip_addresses variant"))
-					return diags
-				}
-				var ips []string
-				if err := listOnly.List.ElementsAs(ctx, &ips, false); err != nil || len(ips) == 0 {
-					diags.Append(diag.NewErrorDiagnostic("filter_rules invalid", "ip_addresses.list must be a non-empty list of strings"))
-					return diags
-				}
-				break
-			}
-			var rangeOnly struct {
-				Range struct {
-					Start types.String `tfsdk:"start"`
-					End   types.String `tfsdk:"end"`
-				} `tfsdk:"range"`
-			}
-			if d := obj.As(ctx, &rangeOnly, basetypes.ObjectAsOptions{UnhandledNullAsEmpty: true, UnhandledUnknownAsEmpty: true}); !d.HasError() {
-				if rangeOnly.Range.Start.IsNull() || rangeOnly.Range.Start.IsUnknown() || rangeOnly.Range.End.IsNull() || rangeOnly.Range.End.IsUnknown() {
-					diags.Append(diag.NewErrorDiagnostic("filter_rules invalid", "ip_addresses.range must include start and end"))
-					return diags
-				}
-				break
-			}
-			diags.Append(diag.NewErrorDiagnostic("filter_rules invalid", "ip_addresses must contain one of: cidr, range, or list"))
+		var ips []string
+		if d := list.ElementsAs(ctx, &ips, false); d.HasError() || len(ips) == 0 {
+			diags.AddError("filter_rules invalid", "ip_addresses.list must be a non-empty list of strings")
+		}
+		return diags
+	}
+	if ipRange, ok := attrs["range"].(types.Object); ok && !ipRange.IsNull() {
+		if ipRange.IsUnknown() {
 			return diags
-		default:
-			diags.Append(diag.NewErrorDiagnostic("filter_rules invalid", "ip_addresses must be an object with cidr, range, or list"))
+		}
+		rangeAttrs := ipRange.Attributes()
+		start, startOK := rangeAttrs["start"].(types.String)
+		end, endOK := rangeAttrs["end"].(types.String)
+		if !startOK || !endOK || start.IsNull() || end.IsNull() {
+			diags.AddError("filter_rules invalid", "ip_addresses.range must include start and end")
+		}
+		return diags
+	}
+
+	diags.AddError("filter_rules invalid", "ip_addresses must contain one of: cidr, range, or list")
+	return diags
+}
+
+// ports may have either a list of ports or a range, with every port between 1 and 65535. An empty list allows any port
+func validateFilterRulePorts(ctx context.Context, ports types.Object) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if ports.IsNull() || ports.IsUnknown() {
+		return diags
+	}
+
+	attrs := ports.Attributes()
+	list, listOK := attrs["list"].(types.List)
+	listPresent := listOK && !list.IsNull() && !list.IsUnknown()
+	portRange, rangeOK := attrs["range"].(types.Object)
+	rangePresent := rangeOK && !portRange.IsNull() && !portRange.IsUnknown()
+	if listPresent && rangePresent {
+		diags.AddError("filter_rules invalid", "ports must contain either 'list' or 'range', not both")
+		return diags
+	}
+
+	if listPresent {
+		var portVals []types.Int64
+		if d := list.ElementsAs(ctx, &portVals, false); d.HasError() {
+			diags.AddError("filter_rules invalid", "ports.list must be a list of port numbers")
 			return diags
 		}
-		// validate ports if present
-		if pv, ok := r["ports"]; ok {
-			// pv may be an attr.Value (types.Object) or types.Object
-			var objVal types.Object
-			switch t := pv.(type) {
-			case types.Object:
-				objVal = t
-			case attr.Value:
-				if vobj, ok := t.(types.Object); ok {
-					objVal = vobj
-				} else {
-					diags.Append(diag.NewErrorDiagnostic("filter_rules invalid", "ports must be an object"))
-					return diags
-				}
-			default:
-				diags.Append(diag.NewErrorDiagnostic("filter_rules invalid", "ports must be an object"))
+		for _, p := range portVals {
+			if p.IsUnknown() {
+				continue
+			}
+			if p.ValueInt64() < 1 || p.ValueInt64() > 65535 {
+				diags.AddError("filter_rules invalid", "port values must be between 1 and 65535")
 				return diags
 			}
+		}
+	}
 
-			// Try decoding as list-only first
-			var listOnly struct {
-				List types.List `tfsdk:"list"`
-			}
-			if ld := objVal.As(ctx, &listOnly, basetypes.ObjectAsOptions{UnhandledNullAsEmpty: true, UnhandledUnknownAsEmpty: true}); !ld.HasError() {
-				if !(listOnly.List.IsNull() || listOnly.List.IsUnknown()) {
-					var portVals []int64
-					if err := listOnly.List.ElementsAs(ctx, &portVals, false); err != nil || len(portVals) == 0 {
-						diags.Append(diag.NewErrorDiagnostic("filter_rules invalid", "ports.list must be a non-empty list of port numbers"))
-						return diags
-					}
-					for _, p := range portVals {
-						if p < 1 || p > 65535 {
-							diags.Append(diag.NewErrorDiagnostic("filter_rules invalid", "port values must be between 1 and 65535"))
-							return diags
-						}
-					}
-				}
-				r["ports"] = objVal
-			} else {
-				// Try decoding as range-only
-				var rangeOnly struct {
-					Range struct {
-						Start types.Int64 `tfsdk:"start"`
-						End   types.Int64 `tfsdk:"end"`
-					} `tfsdk:"range"`
-				}
-				if rd := objVal.As(ctx, &rangeOnly, basetypes.ObjectAsOptions{UnhandledNullAsEmpty: true, UnhandledUnknownAsEmpty: true}); !rd.HasError() {
-					if !(rangeOnly.Range.Start.IsNull() || rangeOnly.Range.Start.IsUnknown() || rangeOnly.Range.End.IsNull() || rangeOnly.Range.End.IsUnknown()) {
-						start := rangeOnly.Range.Start.ValueInt64()
-						end := rangeOnly.Range.End.ValueInt64()
-						if start < 1 || end < 1 || start > 65535 || end > 65535 {
-							diags.Append(diag.NewErrorDiagnostic("filter_rules invalid", "range start/end must be between 1 and 65535"))
-							return diags
-						}
-						if start > end {
-							diags.Append(diag.NewErrorDiagnostic("filter_rules invalid", "range start must be <= range end"))
-							return diags
-						}
-					}
-					r["ports"] = objVal
-				} else {
-					diags.Append(diag.NewErrorDiagnostic("filter_rules invalid", "unable to parse ports object 1"))
-					return diags
-				}
-			}
+	if rangePresent {
+		rangeAttrs := portRange.Attributes()
+		start, startOK := rangeAttrs["start"].(types.Int64)
+		end, endOK := rangeAttrs["end"].(types.Int64)
+		if !startOK || !endOK || start.IsNull() || start.IsUnknown() || end.IsNull() || end.IsUnknown() {
+			return diags
+		}
+		if start.ValueInt64() < 1 || end.ValueInt64() < 1 || start.ValueInt64() > 65535 || end.ValueInt64() > 65535 {
+			diags.AddError("filter_rules invalid", "range start/end must be between 1 and 65535")
+			return diags
+		}
+		if start.ValueInt64() > end.ValueInt64() {
+			diags.AddError("filter_rules invalid", "range start must be <= range end")
 		}
 	}
 
@@ -347,11 +178,16 @@ type networkTunnelJumpResource struct {
 	apiResource[api.NetworkTunnelJump, models.NetworkTunnelJump]
 }
 
+const networkTunnelJumpSchemaVersion int64 = 1
+
 func (r *networkTunnelJumpResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:     networkTunnelJumpSchemaVersion,
 		Description: "Manages a Network Tunnel Jump Item. NOTE: PRA only.",
 		Attributes: map[string]schema.Attribute{
 			"id":                schema.StringAttribute{Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+			"copy_from_id":      copyFromIDSchema(),
+			"adopt_existing":    adoptExistingSchema(),
 			"name":              schema.StringAttribute{Required: true},
 			"jumpoint_id":       schema.Int64Attribute{Required: true},
 			"jump_group_id":     schema.Int64Attribute{Required: true},
@@ -394,3 +230,7 @@ func (r *networkTunnelJumpResource) Schema(_ context.Context, _ resource.SchemaR
 		},
 	}
 }
+
+func (r *networkTunnelJumpResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(networkTunnelJumpSchemaVersion, nil)
+}
@@ -61,3 +61,28 @@ func TestApplyNetworkTunnelPortsRange(t *testing.T) {
 	diags := applyNetworkTunnelValidate(context.Background(), &plan)
 	assert.False(t, diags.HasError())
 }
+
+func TestApplyNetworkTunnelPortsAny(t *testing.T) {
+	var plan models.NetworkTunnelJump
+
+	// rule with ip_addresses and ports.list = [], which allows any port
+	elemType := types.ObjectType{}.WithAttributeTypes(map[string]attr.Type{
+		"ip_addresses": types.ObjectType{AttrTypes: map[string]attr.Type{"list": types.ListType{ElemType: types.StringType}}},
+		"ports":        types.ObjectType{AttrTypes: map[string]attr.Type{"list": types.ListType{ElemType: types.Int64Type}}},
+	})
+
+	portsObj := types.ObjectValueMust(map[string]attr.Type{"list": types.ListType{ElemType: types.Int64Type}}, map[string]attr.Value{"list": types.ListValueMust(types.Int64Type, []attr.Value{})})
+
+	ipList := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("10.0.0.1")})
+	ipObj := types.ObjectValueMust(map[string]attr.Type{"list": types.ListType{ElemType: types.StringType}}, map[string]attr.Value{"list": ipList})
+
+	obj := types.ObjectValueMust(elemType.AttributeTypes(), map[string]attr.Value{
+		"ip_addresses": ipObj,
+		"ports":        portsObj,
+	})
+
+	plan.FilterRules = types.ListValueMust(elemType, []attr.Value{obj})
+
+	diags := applyNetworkTunnelValidate(context.Background(), &plan)
+	assert.False(t, diags.HasError())
+}
@@ -21,6 +21,7 @@ var (
 	_ resource.Resource                = &postgresqlTunnelJumpResource{}
 	_ resource.ResourceWithConfigure   = &postgresqlTunnelJumpResource{}
 	_ resource.ResourceWithImportState = &postgresqlTunnelJumpResource{}
+	_ resource.ResourceWithMoveState   = &postgresqlTunnelJumpResource{}
 )
 
 func newPostgreSQLTunnelJumpResource() resource.Resource { return &postgresqlTunnelJumpResource{} }
@@ -29,11 +30,16 @@ type postgresqlTunnelJumpResource struct {
 	apiResource[api.PostgreSQLTunnelJump, models.PostgreSQLTunnelJump]
 }
 
+const postgresqlTunnelJumpSchemaVersion int64 = 1
+
 func (r *postgresqlTunnelJumpResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:     postgresqlTunnelJumpSchemaVersion,
 		Description: "Manages a PostgreSQL Tunnel Jump Item. NOTE: PRA only.",
 		Attributes: map[string]schema.Attribute{
 			"id":                    schema.StringAttribute{Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+			"copy_from_id":          copyFromIDSchema(),
+			"adopt_existing":        adoptExistingSchema(),
 			"name":                  schema.StringAttribute{Required: true},
 			"jumpoint_id":           schema.Int64Attribute{Required: true},
 			"hostname":              schema.StringAttribute{Required: true},
@@ -50,6 +56,17 @@ func (r *postgresqlTunnelJumpResource) Schema(_ context.Context, _ resource.Sche
 	}
 }
 
+func (r *postgresqlTunnelJumpResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(postgresqlTunnelJumpSchemaVersion, nil)
+}
+
+// PostgreSQL tunnels created with sra_protocol_tunnel_jump can be moved to this resource without re-creating them
+func (r *postgresqlTunnelJumpResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		protocolTunnelStateMover[models.PostgreSQLTunnelJump](ctx, "psql"),
+	}
+}
+
 func (r *postgresqlTunnelJumpResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	tflog.Debug(ctx, "Starting plan modification (postgresql)")
 	if req.Plan.Raw.IsNull() {
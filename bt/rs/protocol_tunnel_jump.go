@@ -37,8 +37,11 @@ type protocolTunnelJumpResource struct {
 	apiResource[api.ProtocolTunnelJump, models.ProtocolTunnelJump]
 }
 
+const protocolTunnelJumpSchemaVersion int64 = 1
+
 func (r *protocolTunnelJumpResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: protocolTunnelJumpSchemaVersion,
 		Description: `Manages a Protocol Tunnel Jump Item.
 
 NOTE: Protocol Tunnel Jumps are PRA only.
@@ -51,6 +54,8 @@ For descriptions of individual fields, please see the Configuration API document
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"copy_from_id":   copyFromIDSchema(),
+			"adopt_existing": adoptExistingSchema(),
 			"name": schema.StringAttribute{
 				Required: true,
 			},
@@ -125,6 +130,10 @@ For descriptions of individual fields, please see the Configuration API document
 	}
 }
 
+func (r *protocolTunnelJumpResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(protocolTunnelJumpSchemaVersion, nil)
+}
+
 func (r *protocolTunnelJumpResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	tflog.Debug(ctx, "Starting plan modification")
 	if req.Plan.Raw.IsNull() {
@@ -173,32 +182,7 @@ func applyProtocolTunnelDefaultsAndValidate(plan *models.ProtocolTunnelJump) dia
 			diags.Append(diag.NewErrorDiagnostic("TunnelDefinitions is required", "You must supply TunnelDefinitions when TunnelType is \"tcp\"."))
 			return diags
 		}
-		parts := strings.Split(plan.TunnelDefinitions.ValueString(), ";")
-		if len(parts)%2 != 0 {
-			diags.Append(diag.NewErrorDiagnostic("TunnelDefinitions invalid", "TunnelDefinitions must contain pairs of local and remote ports separated by ';'"))
-			return diags
-		}
-		for i, p := range parts {
-			p = strings.TrimSpace(p)
-			if p == "" {
-				diags.Append(diag.NewErrorDiagnostic("TunnelDefinitions invalid", "Empty port value in TunnelDefinitions"))
-				continue
-			}
-			v, err := strconv.Atoi(p)
-			if err != nil {
-				diags.Append(diag.NewErrorDiagnostic("TunnelDefinitions invalid", "All tunnel definition values must be integers"))
-				continue
-			}
-			if i%2 == 0 { // local port: 0..65535
-				if v < 0 || v > 65535 {
-					diags.Append(diag.NewErrorDiagnostic("TunnelDefinitions port out of range", "Local ports must be between 0 and 65535"))
-				}
-			} else { // remote port: 1..65535
-				if v < 1 || v > 65535 {
-					diags.Append(diag.NewErrorDiagnostic("TunnelDefinitions port out of range", "Remote ports must be between 1 and 65535"))
-				}
-			}
-		}
+		diags.Append(ValidateTunnelDefinitions(plan.TunnelDefinitions.ValueString())...)
 	}
 
 	// mssql-specific: username required
@@ -242,3 +226,37 @@ func applyProtocolTunnelDefaultsAndValidate(plan *models.ProtocolTunnelJump) dia
 
 	return diags
 }
+
+// ValidateTunnelDefinitions checks a tunnel_definitions value is made up of pairs of local and remote ports
+// separated by ';', with local ports between 0 and 65535 and remote ports between 1 and 65535
+func ValidateTunnelDefinitions(definitions string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	parts := strings.Split(definitions, ";")
+	if len(parts)%2 != 0 {
+		diags.Append(diag.NewErrorDiagnostic("TunnelDefinitions invalid", "TunnelDefinitions must contain pairs of local and remote ports separated by ';'"))
+		return diags
+	}
+	for i, p := range parts {
+		p = strings.TrimSpace(p)
+		if p == "" {
+			diags.Append(diag.NewErrorDiagnostic("TunnelDefinitions invalid", "Empty port value in TunnelDefinitions"))
+			continue
+		}
+		v, err := strconv.Atoi(p)
+		if err != nil {
+			diags.Append(diag.NewErrorDiagnostic("TunnelDefinitions invalid", "All tunnel definition values must be integers"))
+			continue
+		}
+		if i%2 == 0 { // local port: 0..65535
+			if v < 0 || v > 65535 {
+				diags.Append(diag.NewErrorDiagnostic("TunnelDefinitions port out of range", "Local ports must be between 0 and 65535"))
+			}
+		} else { // remote port: 1..65535
+			if v < 1 || v > 65535 {
+				diags.Append(diag.NewErrorDiagnostic("TunnelDefinitions port out of range", "Remote ports must be between 1 and 65535"))
+			}
+		}
+	}
+
+	return diags
+}
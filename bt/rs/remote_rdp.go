@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -34,10 +35,13 @@ type remoteRDPResource struct {
 	apiResource[api.RemoteRDP, models.RemoteRDP]
 }
 
+const remoteRDPSchemaVersion int64 = 1
+
 // We must define the schema for each resource individually. Anything that can be supplied by the API response
 // needs to be marked as "Computed", even if we translate from "null" on a POST to an empty string
 func (r *remoteRDPResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:     remoteRDPSchemaVersion,
 		Description: "Manages a Remote RDP Jump Item.\n\nFor descriptions of individual fields, please see the Configuration API documentation on your SRA Appliance",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -46,6 +50,8 @@ func (r *remoteRDPResource) Schema(_ context.Context, _ resource.SchemaRequest,
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"copy_from_id":   copyFromIDSchema(),
+			"adopt_existing": adoptExistingSchema(),
 			"name": schema.StringAttribute{
 				Required: true,
 			},
@@ -152,12 +158,20 @@ func (r *remoteRDPResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Optional: true,
 			},
 			"endpoint_id": schema.Int64Attribute{
-				Optional: true,
+				Description: "The ID of the Vault Endpoint linked to this Jump Item. Use sra_vault_endpoint_remote_rdp_association to manage this link.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
 			},
 		},
 	}
 }
 
+func (r *remoteRDPResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(remoteRDPSchemaVersion, nil)
+}
+
 func (r *remoteRDPResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	tflog.Debug(ctx, "Starting plan modification")
 	if req.Plan.Raw.IsNull() {
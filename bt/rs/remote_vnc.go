@@ -30,8 +30,11 @@ type remoteVNCResource struct {
 	apiResource[api.RemoteVNC, models.RemoteVNC]
 }
 
+const remoteVNCSchemaVersion int64 = 1
+
 func (r *remoteVNCResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:     remoteVNCSchemaVersion,
 		Description: "Manages a Remote VNC Jump Item.\n\nFor descriptions of individual fields, please see the Configuration API documentation on your SRA Appliance",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -40,6 +43,8 @@ func (r *remoteVNCResource) Schema(_ context.Context, _ resource.SchemaRequest,
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"copy_from_id":   copyFromIDSchema(),
+			"adopt_existing": adoptExistingSchema(),
 			"name": schema.StringAttribute{
 				Required: true,
 			},
@@ -82,3 +87,7 @@ func (r *remoteVNCResource) Schema(_ context.Context, _ resource.SchemaRequest,
 		},
 	}
 }
+
+func (r *remoteVNCResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(remoteVNCSchemaVersion, nil)
+}
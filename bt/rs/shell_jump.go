@@ -47,10 +47,13 @@ type shellJumpResource struct {
 	apiResource[api.ShellJump, models.ShellJump]
 }
 
+const shellJumpSchemaVersion int64 = 1
+
 // We must define the schema for each resource individually. Anything that can be supplied by the API response
 // needs to be marked as "Computed", even if we translate from "null" on a POST to an empty string
 func (r *shellJumpResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:     shellJumpSchemaVersion,
 		Description: "Manages a Shell Jump Item.\n\nFor descriptions of individual fields, please see the Configuration API documentation on your SRA Appliance",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -59,6 +62,8 @@ func (r *shellJumpResource) Schema(_ context.Context, _ resource.SchemaRequest,
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"copy_from_id":   copyFromIDSchema(),
+			"adopt_existing": adoptExistingSchema(),
 			"name": schema.StringAttribute{
 				Required: true,
 			},
@@ -127,6 +132,10 @@ func (r *shellJumpResource) Schema(_ context.Context, _ resource.SchemaRequest,
 	}
 }
 
+func (r *shellJumpResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(shellJumpSchemaVersion, nil)
+}
+
 // In order for Terraform to work as expected, the "plan" supplied by the user to the API must match the result. This
 // function gives us an opportunity to modify the plan and tell Terraform any default values. Unfortunately, it seems
 // like this must be done before sending to the appliance. If terraform complains that the plan isn't stable,
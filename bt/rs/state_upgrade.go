@@ -0,0 +1,82 @@
+package rs
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+/*
+State upgrades. Each resource declares its schema version in a constant next to its schema and passes it to
+stateUpgraders from its UpgradeState. When an attribute of a resource changes type or shape, bump that
+resource's version and give it a stateUpgradeStep, keyed by the old version, that rewrites state from that
+version into the new shape. Other resources keep their version. Resources without a step for a version have their state carried
+forward as-is: attributes that were removed are dropped and attributes that were added start out null.
+
+Version 0 is all state written before schemas were versioned.
+*/
+
+// Rewrites the JSON state of one schema version, in place, into the shape of the next version
+type stateUpgradeStep func(ctx context.Context, state map[string]any) error
+
+// Returns an upgrader for every version before the given schema version. Each one applies the steps from its
+// version up to the current one, then reads the result using the current schema.
+func stateUpgraders(schemaVersion int64, steps map[int64]stateUpgradeStep) map[int64]resource.StateUpgrader {
+	upgraders := make(map[int64]resource.StateUpgrader, schemaVersion)
+	for version := int64(0); version < schemaVersion; version++ {
+		from := version
+		upgraders[from] = resource.StateUpgrader{
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				if req.RawState == nil || req.RawState.JSON == nil {
+					resp.Diagnostics.AddError(
+						"Unable to upgrade state",
+						fmt.Sprintf("The version %d state isn't in JSON form. Refresh it with an older provider version first.", from),
+					)
+					return
+				}
+
+				// Keep numbers exact, since IDs and ports round-trip through here
+				var state map[string]any
+				decoder := json.NewDecoder(bytes.NewReader(req.RawState.JSON))
+				decoder.UseNumber()
+				if err := decoder.Decode(&state); err != nil {
+					resp.Diagnostics.AddError("Unable to upgrade state", fmt.Sprintf("Unable to read the version %d state: %s", from, err))
+					return
+				}
+
+				for v := from; v < schemaVersion; v++ {
+					step, ok := steps[v]
+					if !ok {
+						continue
+					}
+					if err := step(ctx, state); err != nil {
+						resp.Diagnostics.AddError("Unable to upgrade state", fmt.Sprintf("Unable to upgrade state from version %d: %s", v, err))
+						return
+					}
+				}
+
+				upgraded, err := json.Marshal(state)
+				if err != nil {
+					resp.Diagnostics.AddError("Unable to upgrade state", err.Error())
+					return
+				}
+
+				raw := tfprotov6.RawState{JSON: upgraded}
+				resp.State.Raw, err = raw.UnmarshalWithOpts(resp.State.Schema.Type().TerraformType(ctx), tfprotov6.UnmarshalOpts{
+					ValueFromJSONOpts: tftypes.ValueFromJSONOpts{IgnoreUndefinedAttributes: true},
+				})
+				if err != nil {
+					resp.Diagnostics.AddError("Unable to upgrade state", fmt.Sprintf("Unable to read the upgraded version %d state: %s", from, err))
+					return
+				}
+			},
+		}
+	}
+
+	return upgraders
+}
@@ -0,0 +1,190 @@
+package rs
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"terraform-provider-sra/bt/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/stretchr/testify/assert"
+)
+
+// Runs the upgrader for the given version of the resource on the recorded state
+func upgradeState(t *testing.T, r resource.Resource, version int64, recorded string) (tfsdk.State, bool) {
+	ctx := context.Background()
+	var schema resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schema)
+
+	upgrader, ok := r.(resource.ResourceWithUpgradeState).UpgradeState(ctx)[version]
+	assert.True(t, ok)
+
+	resp := resource.UpgradeStateResponse{State: tfsdk.State{Schema: schema.Schema}}
+	upgrader.StateUpgrader(ctx, resource.UpgradeStateRequest{RawState: &tfprotov6.RawState{JSON: []byte(recorded)}}, &resp)
+	assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	return resp.State, !resp.Diagnostics.HasError()
+}
+
+func TestSchemaVersions(t *testing.T) {
+	ctx := context.Background()
+	for _, newResource := range ResourceList() {
+		r := newResource()
+		var metadata resource.MetadataResponse
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "sra"}, &metadata)
+		var schema resource.SchemaResponse
+		r.Schema(ctx, resource.SchemaRequest{}, &schema)
+
+		// Every resource carries its own version, starting at 1 for the state written before versioning
+		version := schema.Schema.Version
+		assert.GreaterOrEqual(t, version, int64(1), metadata.TypeName)
+		upgrader, ok := r.(resource.ResourceWithUpgradeState)
+		if !assert.True(t, ok, fmt.Sprintf("%s doesn't upgrade state", metadata.TypeName)) {
+			continue
+		}
+		upgraders := upgrader.UpgradeState(ctx)
+		assert.Len(t, upgraders, int(version), metadata.TypeName)
+		for v := int64(0); v < version; v++ {
+			assert.Contains(t, upgraders, v, fmt.Sprintf("%s has no upgrader for version %d", metadata.TypeName, v))
+		}
+	}
+}
+
+// Version 0 state as written by the provider before schemas were versioned
+func TestUpgradeStateFromVersion0(t *testing.T) {
+	ctx := context.Background()
+
+	{
+		state, ok := upgradeState(t, newShellJumpResource(), 0, `{
+			"id": "42", "name": "web", "jumpoint_id": 1, "hostname": "web.example.com", "protocol": "ssh", "port": 22,
+			"jump_group_id": 3, "jump_group_type": "shared", "terminal": "xterm", "keep_alive": 0, "tag": "", "comments": "",
+			"jump_policy_id": null, "username": "", "session_policy_id": null
+		}`)
+		if ok {
+			var upgraded models.ShellJump
+			assert.False(t, state.Get(ctx, &upgraded).HasError())
+			assert.Equal(t, "42", upgraded.ID.ValueString())
+			assert.Equal(t, int64(22), upgraded.Port.ValueInt64())
+			assert.True(t, upgraded.JumpPolicyID.IsNull())
+		}
+	}
+
+	{
+		state, ok := upgradeState(t, newNetworkTunnelJumpResource(), 0, `{
+			"id": "7", "name": "Network", "jumpoint_id": 1, "jump_group_id": 3, "jump_group_type": "shared", "tag": "", "comments": "",
+			"jump_policy_id": null, "session_policy_id": null,
+			"filter_rules": [
+				{"ip_addresses": {"cidr": "10.0.0.0/24", "list": null, "range": null}, "ports": {"list": [443, 8443], "range": null}, "protocol": "TCP"},
+				{"ip_addresses": {"cidr": null, "list": null, "range": {"start": "10.0.1.1", "end": "10.0.1.9"}}, "ports": {"list": null, "range": {"start": 1000, "end": 2000}}, "protocol": "UDP"}
+			]
+		}`)
+		if ok {
+			var upgraded models.NetworkTunnelJump
+			assert.False(t, state.Get(ctx, &upgraded).HasError())
+			assert.Len(t, upgraded.FilterRules.Elements(), 2)
+			assert.False(t, ValidateFilterRules(ctx, upgraded.FilterRules).HasError())
+		}
+	}
+
+	{
+		state, ok := upgradeState(t, newJumpGroupResource(), 0, `{
+			"id": "3", "name": "Linux", "code_name": "linux", "comments": "",
+			"group_policy_memberships": [{"group_policy_id": "12", "jump_item_role_id": 0, "jump_policy_id": 0}]
+		}`)
+		if ok {
+			var upgraded models.JumpGroup
+			assert.False(t, state.Get(ctx, &upgraded).HasError())
+			assert.Len(t, upgraded.GroupPolicyMemberships.Elements(), 1)
+		}
+	}
+
+	{
+		// Written before the write-only password attributes existed
+		state, ok := upgradeState(t, newVaultUsernamePasswordAccountResource(), 0, `{
+			"id": "21", "type": "username_password", "name": "root", "description": "", "personal": false, "owner_user_id": null,
+			"account_group_id": 1, "account_policy": null, "username": "root", "password": "hunter2", "last_checkout_timestamp": null,
+			"jump_item_association": null, "group_policy_memberships": null
+		}`)
+		if ok {
+			var upgraded models.VaultUsernamePasswordAccount
+			assert.False(t, state.Get(ctx, &upgraded).HasError())
+			assert.Equal(t, "hunter2", upgraded.Password.ValueString())
+			assert.True(t, upgraded.PasswordWOVersion.IsNull())
+		}
+	}
+
+	{
+		state, ok := upgradeState(t, newJumpGroupUserResource(), 0, `{
+			"id": "3/9007199254740993", "jump_group_id": 3, "user_id": 9007199254740993, "jump_item_role_id": 2, "jump_policy_id": 0
+		}`)
+		if ok {
+			var upgraded models.JumpGroupUser
+			assert.False(t, state.Get(ctx, &upgraded).HasError())
+			// Large numbers stay exact
+			assert.Equal(t, int64(9007199254740993), upgraded.UserID.ValueInt64())
+		}
+	}
+}
+
+func TestStateUpgradeSteps(t *testing.T) {
+	ctx := context.Background()
+	var schema resource.SchemaResponse
+	newJumpGroupResource().Schema(ctx, resource.SchemaRequest{}, &schema)
+
+	// A step for version 0 that renames an attribute, and drops one the schema no longer has
+	upgraders := stateUpgraders(1, map[int64]stateUpgradeStep{
+		0: func(_ context.Context, state map[string]any) error {
+			state["comments"] = state["notes"]
+			delete(state, "notes")
+			return nil
+		},
+	})
+	resp := resource.UpgradeStateResponse{State: tfsdk.State{Schema: schema.Schema}}
+	upgraders[0].StateUpgrader(ctx, resource.UpgradeStateRequest{
+		RawState: &tfprotov6.RawState{JSON: []byte(`{"id": "3", "name": "Linux", "code_name": "linux", "notes": "servers", "removed": true}`)},
+	}, &resp)
+	assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var upgraded models.JumpGroup
+	assert.False(t, resp.State.Get(ctx, &upgraded).HasError())
+	assert.Equal(t, types.StringValue("servers"), upgraded.Comments)
+	assert.True(t, upgraded.GroupPolicyMemberships.IsNull())
+
+	// Errors from a step stop the upgrade
+	upgraders = stateUpgraders(1, map[int64]stateUpgradeStep{
+		0: func(_ context.Context, _ map[string]any) error { return fmt.Errorf("bad state") },
+	})
+	resp = resource.UpgradeStateResponse{State: tfsdk.State{Schema: schema.Schema}}
+	upgraders[0].StateUpgrader(ctx, resource.UpgradeStateRequest{RawState: &tfprotov6.RawState{JSON: []byte(`{}`)}}, &resp)
+	assert.True(t, resp.Diagnostics.HasError())
+	assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), "bad state")
+
+	// A resource at version 2 chains the steps from the version of the state up to its own
+	steps := map[int64]stateUpgradeStep{
+		0: func(_ context.Context, state map[string]any) error {
+			state["notes"] = state["description"]
+			delete(state, "description")
+			return nil
+		},
+		1: func(_ context.Context, state map[string]any) error {
+			state["comments"] = state["notes"]
+			delete(state, "notes")
+			return nil
+		},
+	}
+	upgraders = stateUpgraders(2, steps)
+	assert.Len(t, upgraders, 2)
+	for version, recorded := range map[int64]string{
+		0: `{"id": "3", "name": "Linux", "code_name": "linux", "description": "servers"}`,
+		1: `{"id": "3", "name": "Linux", "code_name": "linux", "notes": "servers"}`,
+	} {
+		resp = resource.UpgradeStateResponse{State: tfsdk.State{Schema: schema.Schema}}
+		upgraders[version].StateUpgrader(ctx, resource.UpgradeStateRequest{RawState: &tfprotov6.RawState{JSON: []byte(recorded)}}, &resp)
+		assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+		assert.False(t, resp.State.Get(ctx, &upgraded).HasError())
+		assert.Equal(t, types.StringValue("servers"), upgraded.Comments, version)
+	}
+}
@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
-	"sync"
 	"terraform-provider-sra/api"
 	"terraform-provider-sra/bt/models"
 
@@ -22,8 +21,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-
-	mapset "github.com/deckarep/golang-set/v2"
 )
 
 // These throw away variable declarations are to allow the compiler to
@@ -32,12 +29,7 @@ var (
 	_ resource.Resource                = &vaultAccountGroupResource{}
 	_ resource.ResourceWithConfigure   = &vaultAccountGroupResource{}
 	_ resource.ResourceWithImportState = &vaultAccountGroupResource{}
-	// _ resource.ResourceWithModifyPlan  = &vaultAccountGroupResource{}
-
-	// Because of the way the PHP code handles changing memberships, those
-	// operations cannot be done in parallel. We use this mutex to ensure
-	// we deal with membership updates one at a time
-	agMembershipMutex sync.Mutex
+	_ resource.ResourceWithModifyPlan  = &vaultAccountGroupResource{}
 )
 
 func newVaultAccountGroupResource() resource.Resource {
@@ -48,6 +40,8 @@ type vaultAccountGroupResource struct {
 	apiResource[api.VaultAccountGroup, models.VaultAccountGroup]
 }
 
+const vaultAccountGroupSchemaVersion int64 = 1
+
 func (r *vaultAccountGroupResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	jiaSchema := accountJumpItemAssociationSchema()
 
@@ -86,6 +80,7 @@ func (r *vaultAccountGroupResource) Schema(ctx context.Context, _ resource.Schem
 	jiaSchema.Default = objectdefault.StaticValue(tfDefault)
 
 	resp.Schema = schema.Schema{
+		Version:     vaultAccountGroupSchemaVersion,
 		Description: "Manages a Vault Account Group.\n\nFor descriptions of individual fields, please see the Configuration API documentation on your SRA Appliance",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -94,6 +89,7 @@ func (r *vaultAccountGroupResource) Schema(ctx context.Context, _ resource.Schem
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"adopt_existing": adoptExistingSchema(),
 			"name": schema.StringAttribute{
 				Required: true,
 			},
@@ -106,7 +102,8 @@ func (r *vaultAccountGroupResource) Schema(ctx context.Context, _ resource.Schem
 				Optional: true,
 			},
 
-			"jump_item_association": jiaSchema,
+			"jump_item_association":        jiaSchema,
+			"group_policy_membership_mode": groupPolicyMembershipModeSchema(),
 			"group_policy_memberships": schema.SetNestedAttribute{
 				Optional: true,
 				NestedObject: schema.NestedAttributeObject{
@@ -128,6 +125,14 @@ func (r *vaultAccountGroupResource) Schema(ctx context.Context, _ resource.Schem
 	}
 }
 
+func (r *vaultAccountGroupResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(vaultAccountGroupSchemaVersion, nil)
+}
+
+func (r *vaultAccountGroupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	checkInlineMemberships(ctx, req.Plan, "account_group_id", "group_policy_vault_account_group", &resp.Diagnostics)
+}
+
 func (r *vaultAccountGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	r.apiResource.Create(ctx, req, resp)
 	if resp.Diagnostics.HasError() {
@@ -221,49 +226,13 @@ func (r *vaultAccountGroupResource) Create(ctx context.Context, req resource.Cre
 			return
 		}
 
-		setGPList := mapset.NewSet(gpList...)
-
-		tflog.Trace(ctx, "🌈 Updating group policy memberships", map[string]interface{}{
-			"add": setGPList,
-
-			"tf":   tfGPList,
-			"list": gpList,
-		})
-
-		agMembershipMutex.Lock()
-		defer agMembershipMutex.Unlock()
-
-		results := []api.GroupPolicyVaultAccountGroup{}
-		needsProvision := mapset.NewSet[string]()
-		for m := range setGPList.Iterator().C {
-			m.AccountGroupID = &id
-			item, err := api.CreateItem(r.ApiClient, m)
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					"Error updating item's group policy memberships",
-					"Unexpected adding membership of item ID ["+strconv.Itoa(id)+"]: "+err.Error(),
-				)
-				return
-			}
-			item.GroupPolicyID = m.GroupPolicyID
-			results = append(results, *item)
-			needsProvision.Add(*m.GroupPolicyID)
-		}
-
-		for id := range needsProvision.Iter() {
-			p := api.GroupPolicyProvision{
-				GroupPolicyID: &id,
-			}
-			_, err := api.CreateItem(r.ApiClient, p)
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					"Error provisioning item's group policy memberships",
-					"Unexpected response provisioning membership of item ID ["+*p.GroupPolicyID+"]: "+err.Error(),
-				)
-				return
-			}
+		results, err := applyGroupPolicyMemberships[api.GroupPolicyVaultAccountGroup](ctx, r.ApiClient, id, gpList, nil)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error adding item's group policy memberships",
+				"Unexpected adding memberships of item ID ["+strconv.Itoa(id)+"]: "+err.Error(),
+			)
+			return
 		}
 
 		diags = resp.State.SetAttribute(ctx, path.Root("group_policy_memberships"), results)
@@ -278,7 +247,7 @@ func (r *vaultAccountGroupResource) Create(ctx context.Context, req resource.Cre
 
 func (r *vaultAccountGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	r.apiResource.Read(ctx, req, resp)
-	if resp.Diagnostics.HasError() {
+	if resp.Diagnostics.HasError() || resourceGone(resp) {
 		return
 	}
 	tflog.Debug(ctx, "🤬 Account Group reading state")
@@ -336,59 +305,7 @@ func (r *vaultAccountGroupResource) Read(ctx context.Context, req resource.ReadR
 
 	readJIA()
 
-	readGP := func() {
-		// Group Policy Memberships
-
-		var tfGPList types.Set
-		diags := req.State.GetAttribute(ctx, path.Root("group_policy_memberships"), &tfGPList)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-
-		if tfGPList.IsNull() {
-			return
-		}
-
-		var gpList []api.GroupPolicyVaultAccountGroup
-		diags = tfGPList.ElementsAs(ctx, &gpList, false)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-
-		for i, m := range gpList {
-			m.AccountGroupID = &id
-			tflog.Trace(ctx, "🌈 Reading item", map[string]interface{}{
-				"read": m,
-			})
-			gpId := *m.GroupPolicyID
-
-			endpoint := fmt.Sprintf("%s/%d", m.Endpoint(), id)
-			item, err := api.GetItemEndpoint[api.GroupPolicyVaultAccountGroup](r.ApiClient, endpoint)
-
-			if err != nil {
-				tflog.Debug(ctx, "🌈 Error reading item item, skipping", map[string]interface{}{
-					"read":  m,
-					"error": err,
-				})
-			} else if item != nil {
-				tflog.Trace(ctx, "🌈 Read item", map[string]interface{}{
-					"read": *item,
-				})
-				item.GroupPolicyID = &gpId
-				gpList[i] = *item
-			}
-		}
-
-		diags = resp.State.SetAttribute(ctx, path.Root("group_policy_memberships"), gpList)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-	}
-
-	readGP()
+	readGroupPolicyMemberships[api.GroupPolicyVaultAccountGroup](ctx, r.ApiClient, req.State, resp, id)
 }
 
 func (r *vaultAccountGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -501,80 +418,16 @@ func (r *vaultAccountGroupResource) Update(ctx context.Context, req resource.Upd
 			}
 		}
 
-		toAdd, toRemove, noChange := api.DiffGPAccountGroupLists(gpList, stateGPList)
-
-		tflog.Trace(ctx, "🌈 Updating group policy memberships", map[string]interface{}{
-			"add":      fmt.Sprintf("%+v", toAdd),
-			"remove":   fmt.Sprintf("%+v", toRemove),
-			"noChange": fmt.Sprintf("%+v", noChange),
-
-			"tfPlan":    fmt.Sprintf("%+v", tfGPList),
-			"tfState":   fmt.Sprintf("%+v", tfGPStateList),
-			"planList":  fmt.Sprintf("%+v", gpList),
-			"stateList": fmt.Sprintf("%+v", stateGPList),
-		})
-
-		if tfGPList.IsNull() && tfGPStateList.IsNull() {
+		results, err := applyGroupPolicyMemberships[api.GroupPolicyVaultAccountGroup](ctx, r.ApiClient, id, gpList, stateGPList)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating item's group policy memberships",
+				"Unexpected updating memberships of item ID ["+strconv.Itoa(id)+"]: "+err.Error(),
+			)
 			return
 		}
 
-		agMembershipMutex.Lock()
-		defer agMembershipMutex.Unlock()
-
-		needsProvision := mapset.NewSet[string]()
-		for m := range toRemove.Iterator().C {
-			m.AccountGroupID = &id
-			tflog.Trace(ctx, "🌈 Deleting item", map[string]interface{}{
-				"add":     m,
-				"gp":      m.GroupPolicyID,
-				"account": m.AccountGroupID,
-			})
-			endpoint := fmt.Sprintf("%s/%d", m.Endpoint(), *m.AccountGroupID)
-			err := api.DeleteItemEndpoint[api.GroupPolicyVaultAccountGroup](r.ApiClient, endpoint)
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					"Error updating item's group policy memberships",
-					"Unexpected deleting membership of item ID ["+strconv.Itoa(id)+"]: "+err.Error(),
-				)
-				return
-			}
-			needsProvision.Add(*m.GroupPolicyID)
-		}
-
-		results := noChange.ToSlice()
-		for m := range toAdd.Iterator().C {
-			m.AccountGroupID = &id
-			item, err := api.CreateItem(r.ApiClient, m)
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					"Error updating item's group policy memberships",
-					"Unexpected adding membership of item ID ["+strconv.Itoa(id)+"]: "+err.Error(),
-				)
-				return
-			}
-			item.GroupPolicyID = m.GroupPolicyID
-			results = append(results, *item)
-			needsProvision.Add(*m.GroupPolicyID)
-		}
-
-		for id := range needsProvision.Iter() {
-			p := api.GroupPolicyProvision{
-				GroupPolicyID: &id,
-			}
-			_, err := api.CreateItem(r.ApiClient, p)
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					"Error provisioning item's group policy memberships",
-					"Unexpected response provisioning membership of item ID ["+*p.GroupPolicyID+"]: "+err.Error(),
-				)
-				return
-			}
-		}
-
-		diags = resp.State.SetAttribute(ctx, path.Root("group_policy_memberships"), results)
+		diags = setGroupPolicyMemberships(ctx, tfGPList, &resp.State, results)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
 			return
@@ -0,0 +1,40 @@
+package rs
+
+import (
+	"context"
+	"terraform-provider-sra/api"
+	"terraform-provider-sra/bt/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// These throw away variable declarations are to allow the compiler to
+// enforce compliance to these interfaces
+var (
+	_ resource.Resource                = &vaultAccountGroupJumpItemAssociationResource{}
+	_ resource.ResourceWithConfigure   = &vaultAccountGroupJumpItemAssociationResource{}
+	_ resource.ResourceWithImportState = &vaultAccountGroupJumpItemAssociationResource{}
+	_ resource.ResourceWithIdentity    = &vaultAccountGroupJumpItemAssociationResource{}
+)
+
+func newVaultAccountGroupJumpItemAssociationResource() resource.Resource {
+	return &vaultAccountGroupJumpItemAssociationResource{
+		jumpItemAssociationEntryResource[api.VaultAccountGroupJumpItemAssociation, *api.VaultAccountGroupJumpItemAssociation, models.VaultAccountGroupJumpItemAssociation]{
+			parentAttr: "account_group_id",
+			typeAttr:   "jump_item_type",
+			entryAttr:  "jump_item_id",
+		},
+	}
+}
+
+type vaultAccountGroupJumpItemAssociationResource struct {
+	jumpItemAssociationEntryResource[api.VaultAccountGroupJumpItemAssociation, *api.VaultAccountGroupJumpItemAssociation, models.VaultAccountGroupJumpItemAssociation]
+}
+
+func (r *vaultAccountGroupJumpItemAssociationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = jumpItemAssociationEntrySchema(
+		"Adds a Jump Item to the Jump Item association criteria of a Vault Account Group, so the Accounts in the group can be injected into sessions started from it.",
+		r.parentAttr, "The ID of the Vault Account Group",
+		r.typeAttr, r.entryAttr, "The ID of the Jump Item",
+	)
+}
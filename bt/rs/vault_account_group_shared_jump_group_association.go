@@ -0,0 +1,39 @@
+package rs
+
+import (
+	"context"
+	"terraform-provider-sra/api"
+	"terraform-provider-sra/bt/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// These throw away variable declarations are to allow the compiler to
+// enforce compliance to these interfaces
+var (
+	_ resource.Resource                = &vaultAccountGroupSharedJumpGroupAssociationResource{}
+	_ resource.ResourceWithConfigure   = &vaultAccountGroupSharedJumpGroupAssociationResource{}
+	_ resource.ResourceWithImportState = &vaultAccountGroupSharedJumpGroupAssociationResource{}
+	_ resource.ResourceWithIdentity    = &vaultAccountGroupSharedJumpGroupAssociationResource{}
+)
+
+func newVaultAccountGroupSharedJumpGroupAssociationResource() resource.Resource {
+	return &vaultAccountGroupSharedJumpGroupAssociationResource{
+		jumpItemAssociationEntryResource[api.VaultAccountGroupSharedJumpGroupAssociation, *api.VaultAccountGroupSharedJumpGroupAssociation, models.VaultAccountGroupSharedJumpGroupAssociation]{
+			parentAttr: "account_group_id",
+			entryAttr:  "shared_jump_group_id",
+		},
+	}
+}
+
+type vaultAccountGroupSharedJumpGroupAssociationResource struct {
+	jumpItemAssociationEntryResource[api.VaultAccountGroupSharedJumpGroupAssociation, *api.VaultAccountGroupSharedJumpGroupAssociation, models.VaultAccountGroupSharedJumpGroupAssociation]
+}
+
+func (r *vaultAccountGroupSharedJumpGroupAssociationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = jumpItemAssociationEntrySchema(
+		"Adds a shared Jump Group to the Jump Item association criteria of a Vault Account Group, so the Accounts in the group can be injected into sessions started from the Jump Items in it.",
+		r.parentAttr, "The ID of the Vault Account Group",
+		"", r.entryAttr, "The ID of the shared Jump Group",
+	)
+}
@@ -0,0 +1,39 @@
+package rs
+
+import (
+	"context"
+	"terraform-provider-sra/api"
+	"terraform-provider-sra/bt/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// These throw away variable declarations are to allow the compiler to
+// enforce compliance to these interfaces
+var (
+	_ resource.Resource                = &vaultAccountGroupUserResource{}
+	_ resource.ResourceWithConfigure   = &vaultAccountGroupUserResource{}
+	_ resource.ResourceWithImportState = &vaultAccountGroupUserResource{}
+	_ resource.ResourceWithIdentity    = &vaultAccountGroupUserResource{}
+)
+
+func newVaultAccountGroupUserResource() resource.Resource {
+	return &vaultAccountGroupUserResource{
+		vaultUserMembershipResource[api.VaultAccountGroupUser, *api.VaultAccountGroupUser, models.VaultAccountGroupUser]{
+			parentAttr: "account_group_id",
+			parentName: "Vault Account Group",
+		},
+	}
+}
+
+type vaultAccountGroupUserResource struct {
+	vaultUserMembershipResource[api.VaultAccountGroupUser, *api.VaultAccountGroupUser, models.VaultAccountGroupUser]
+}
+
+func (r *vaultAccountGroupUserResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = vaultUserMembershipSchema(`Manages a direct user membership of a Vault Account Group.
+
+This grants a single user access to every account in the group, independent of any group policy memberships. Users that get access through a group policy can't be managed with this resource.`,
+		r.parentAttr,
+	)
+}
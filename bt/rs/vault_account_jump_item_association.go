@@ -0,0 +1,40 @@
+package rs
+
+import (
+	"context"
+	"terraform-provider-sra/api"
+	"terraform-provider-sra/bt/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// These throw away variable declarations are to allow the compiler to
+// enforce compliance to these interfaces
+var (
+	_ resource.Resource                = &vaultAccountJumpItemAssociationResource{}
+	_ resource.ResourceWithConfigure   = &vaultAccountJumpItemAssociationResource{}
+	_ resource.ResourceWithImportState = &vaultAccountJumpItemAssociationResource{}
+	_ resource.ResourceWithIdentity    = &vaultAccountJumpItemAssociationResource{}
+)
+
+func newVaultAccountJumpItemAssociationResource() resource.Resource {
+	return &vaultAccountJumpItemAssociationResource{
+		jumpItemAssociationEntryResource[api.VaultAccountJumpItemAssociation, *api.VaultAccountJumpItemAssociation, models.VaultAccountJumpItemAssociation]{
+			parentAttr: "account_id",
+			typeAttr:   "jump_item_type",
+			entryAttr:  "jump_item_id",
+		},
+	}
+}
+
+type vaultAccountJumpItemAssociationResource struct {
+	jumpItemAssociationEntryResource[api.VaultAccountJumpItemAssociation, *api.VaultAccountJumpItemAssociation, models.VaultAccountJumpItemAssociation]
+}
+
+func (r *vaultAccountJumpItemAssociationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = jumpItemAssociationEntrySchema(
+		"Adds a Jump Item to the Jump Item association criteria of a Vault Account, so the Account can be injected into sessions started from it.",
+		r.parentAttr, "The ID of the Vault Account",
+		r.typeAttr, r.entryAttr, "The ID of the Jump Item",
+	)
+}
@@ -29,8 +29,11 @@ type vaultAccountPolicyResource struct {
 	apiResource[api.VaultAccountPolicy, models.VaultAccountPolicy]
 }
 
+const vaultAccountPolicySchemaVersion int64 = 1
+
 func (r *vaultAccountPolicyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:     vaultAccountPolicySchemaVersion,
 		Description: "Manages a Vault Account Policy.\n\nFor descriptions of individual fields, please see the Configuration API documentation on your SRA Appliance",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -39,6 +42,7 @@ func (r *vaultAccountPolicyResource) Schema(_ context.Context, _ resource.Schema
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"adopt_existing": adoptExistingSchema(),
 			"name": schema.StringAttribute{
 				Required: true,
 			},
@@ -66,3 +70,7 @@ func (r *vaultAccountPolicyResource) Schema(_ context.Context, _ resource.Schema
 		},
 	}
 }
+
+func (r *vaultAccountPolicyResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(vaultAccountPolicySchemaVersion, nil)
+}
@@ -0,0 +1,39 @@
+package rs
+
+import (
+	"context"
+	"terraform-provider-sra/api"
+	"terraform-provider-sra/bt/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// These throw away variable declarations are to allow the compiler to
+// enforce compliance to these interfaces
+var (
+	_ resource.Resource                = &vaultAccountSharedJumpGroupAssociationResource{}
+	_ resource.ResourceWithConfigure   = &vaultAccountSharedJumpGroupAssociationResource{}
+	_ resource.ResourceWithImportState = &vaultAccountSharedJumpGroupAssociationResource{}
+	_ resource.ResourceWithIdentity    = &vaultAccountSharedJumpGroupAssociationResource{}
+)
+
+func newVaultAccountSharedJumpGroupAssociationResource() resource.Resource {
+	return &vaultAccountSharedJumpGroupAssociationResource{
+		jumpItemAssociationEntryResource[api.VaultAccountSharedJumpGroupAssociation, *api.VaultAccountSharedJumpGroupAssociation, models.VaultAccountSharedJumpGroupAssociation]{
+			parentAttr: "account_id",
+			entryAttr:  "shared_jump_group_id",
+		},
+	}
+}
+
+type vaultAccountSharedJumpGroupAssociationResource struct {
+	jumpItemAssociationEntryResource[api.VaultAccountSharedJumpGroupAssociation, *api.VaultAccountSharedJumpGroupAssociation, models.VaultAccountSharedJumpGroupAssociation]
+}
+
+func (r *vaultAccountSharedJumpGroupAssociationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = jumpItemAssociationEntrySchema(
+		"Adds a shared Jump Group to the Jump Item association criteria of a Vault Account, so the Account can be injected into sessions started from the Jump Items in it.",
+		r.parentAttr, "The ID of the Vault Account",
+		"", r.entryAttr, "The ID of the shared Jump Group",
+	)
+}
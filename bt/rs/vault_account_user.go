@@ -0,0 +1,39 @@
+package rs
+
+import (
+	"context"
+	"terraform-provider-sra/api"
+	"terraform-provider-sra/bt/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// These throw away variable declarations are to allow the compiler to
+// enforce compliance to these interfaces
+var (
+	_ resource.Resource                = &vaultAccountUserResource{}
+	_ resource.ResourceWithConfigure   = &vaultAccountUserResource{}
+	_ resource.ResourceWithImportState = &vaultAccountUserResource{}
+	_ resource.ResourceWithIdentity    = &vaultAccountUserResource{}
+)
+
+func newVaultAccountUserResource() resource.Resource {
+	return &vaultAccountUserResource{
+		vaultUserMembershipResource[api.VaultAccountUser, *api.VaultAccountUser, models.VaultAccountUser]{
+			parentAttr: "account_id",
+			parentName: "Vault Account",
+		},
+	}
+}
+
+type vaultAccountUserResource struct {
+	vaultUserMembershipResource[api.VaultAccountUser, *api.VaultAccountUser, models.VaultAccountUser]
+}
+
+func (r *vaultAccountUserResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = vaultUserMembershipSchema(`Manages a direct user membership of a Vault Account.
+
+This grants a single user access to the account, independent of any group policy memberships. Users that get access through a group policy or an account group can't be managed with this resource.`,
+		r.parentAttr,
+	)
+}
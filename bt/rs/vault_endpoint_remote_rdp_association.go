@@ -0,0 +1,226 @@
+package rs
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-sra/api"
+	"terraform-provider-sra/bt/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// These throw away variable declarations are to allow the compiler to
+// enforce compliance to these interfaces
+var (
+	_ resource.Resource                = &vaultEndpointRemoteRDPAssociationResource{}
+	_ resource.ResourceWithConfigure   = &vaultEndpointRemoteRDPAssociationResource{}
+	_ resource.ResourceWithImportState = &vaultEndpointRemoteRDPAssociationResource{}
+	_ resource.ResourceWithIdentity    = &vaultEndpointRemoteRDPAssociationResource{}
+)
+
+func newVaultEndpointRemoteRDPAssociationResource() resource.Resource {
+	return &vaultEndpointRemoteRDPAssociationResource{}
+}
+
+// The association is stored as the endpoint_id of the Remote RDP Jump Item, so
+// that is where we look to find out if it still exists
+type vaultEndpointRemoteRDPAssociationResource struct {
+	apiResource[api.VaultEndpointRemoteRDPAssociation, models.VaultEndpointRemoteRDPAssociation]
+}
+
+const vaultEndpointRemoteRDPAssociationSchemaVersion int64 = 1
+
+func (r *vaultEndpointRemoteRDPAssociationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version: vaultEndpointRemoteRDPAssociationSchemaVersion,
+		MarkdownDescription: `Associates a Vault Endpoint with a Remote RDP Jump Item, so that the Endpoint's credentials can be injected into sessions started from the Jump Item.
+
+Use the ` + "`sra_vault_endpoint_remote_rdp_candidate_list`" + ` data source to find the Jump Items that can be associated with an Endpoint.
+
+The API does not provide a way to remove an association, so destroying this resource only removes it from the Terraform state and the Jump Item stays linked to the Endpoint. Remove the link in the /login interface if it is no longer needed.
+
+For descriptions of individual fields, please see the Configuration API documentation on your SRA Appliance`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the association in the form <endpoint_id>/<jump_item_id>",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"endpoint_id": schema.Int64Attribute{
+				Description: "The ID of the Vault Endpoint",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"jump_item_id": schema.Int64Attribute{
+				Description: "The ID of the Remote RDP Jump Item",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *vaultEndpointRemoteRDPAssociationResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(vaultEndpointRemoteRDPAssociationSchemaVersion, nil)
+}
+
+func (r *vaultEndpointRemoteRDPAssociationResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = compositeIdentitySchema("endpoint_id", "jump_item_id")
+}
+
+func (r *vaultEndpointRemoteRDPAssociationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan models.VaultEndpointRemoteRDPAssociation
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpointID := int(plan.EndpointID.ValueInt64())
+	jumpItemID := int(plan.JumpItemID.ValueInt64())
+	item := api.VaultEndpointRemoteRDPAssociation{
+		EndpointID: &endpointID,
+		ID:         &jumpItemID,
+	}
+
+	tflog.Debug(ctx, "🙀 associating vault endpoint with remote rdp", map[string]interface{}{
+		"endpoint":  endpointID,
+		"jump item": jumpItemID,
+	})
+	_, err := api.CreateItem(r.ApiClient, item)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error associating Vault Endpoint",
+			fmt.Sprintf("Unexpected error associating endpoint [%d] with Remote RDP Jump Item [%d]: %s", endpointID, jumpItemID, err.Error()),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(compositeID(endpointID, jumpItemID))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setCompositeIdentity(ctx, resp.Identity, plan.ID, "endpoint_id", "jump_item_id")...)
+}
+
+func (r *vaultEndpointRemoteRDPAssociationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.VaultEndpointRemoteRDPAssociation
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpointID, jumpItemID, err := parseCompositeID(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading Vault Endpoint association", err.Error())
+		return
+	}
+
+	item, err := api.GetItem[api.RemoteRDP](r.ApiClient, &jumpItemID)
+	if api.IsNotFound(err) {
+		// Deleting the Jump Item removes the association with it
+		item = &api.RemoteRDP{}
+	} else if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Vault Endpoint association",
+			fmt.Sprintf("Unexpected error reading Remote RDP Jump Item [%d]: %s", jumpItemID, err.Error()),
+		)
+		return
+	}
+
+	if item.EndpointID == nil || *item.EndpointID != endpointID {
+		tflog.Debug(ctx, "🙀 remote rdp is no longer associated with the endpoint", map[string]interface{}{
+			"endpoint":  endpointID,
+			"jump item": jumpItemID,
+		})
+		removeFromState(ctx, resp, setCompositeIdentity(ctx, resp.Identity, state.ID, "endpoint_id", "jump_item_id"))
+		return
+	}
+
+	state.EndpointID = types.Int64Value(int64(endpointID))
+	state.JumpItemID = types.Int64Value(int64(jumpItemID))
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setCompositeIdentity(ctx, resp.Identity, state.ID, "endpoint_id", "jump_item_id")...)
+}
+
+// Every attribute requires replacement, so there is nothing to send to the API here
+func (r *vaultEndpointRemoteRDPAssociationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan models.VaultEndpointRemoteRDPAssociation
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setCompositeIdentity(ctx, resp.Identity, plan.ID, "endpoint_id", "jump_item_id")...)
+}
+
+// The API has no way to remove the association, and the Jump Item's endpoint_id is read only, so the link
+// stays in place. Say so, rather than letting the destroy look like it removed it
+func (r *vaultEndpointRemoteRDPAssociationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.VaultEndpointRemoteRDPAssociation
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Vault Endpoint associations can't be removed through the API, removing from state only")
+	resp.Diagnostics.AddWarning(
+		"Vault Endpoint association was only removed from the state",
+		fmt.Sprintf("The API can't remove the association of Vault Endpoint [%d] with Remote RDP Jump Item [%d], so it is still in place. Remove it in the /login interface if it is no longer needed.", state.EndpointID.ValueInt64(), state.JumpItemID.ValueInt64()),
+	)
+}
+
+// Imports using an ID in the form <endpoint_id>/<jump_item_id>, or an identity with both IDs
+func (r *vaultEndpointRemoteRDPAssociationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importID := req.ID
+	if importID == "" && req.Identity != nil {
+		var diags diag.Diagnostics
+		importID, diags = compositeIDFromIdentity(ctx, req.Identity, "endpoint_id", "jump_item_id")
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	endpointID, jumpItemID, err := parseCompositeID(importID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), compositeID(endpointID, jumpItemID))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("endpoint_id"), int64(endpointID))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("jump_item_id"), int64(jumpItemID))...)
+}
@@ -0,0 +1,295 @@
+package rs
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"terraform-provider-sra/api"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+/*
+The Jump Item association entry resources add a single Jump Item or shared Jump Group to the Jump Item association
+criteria of a Vault Account or Account Group, without managing the rest of the association. This lets the
+configuration that owns a Jump Item attach it to a shared credential owned by another configuration.
+
+Every attribute requires replacement, so there is nothing to update. The entries can't be read individually, so
+Read looks for them in the whole association.
+
+Jump Items of different types can have the same ID, so Jump Item entries have a typeAttr, and their IDs and
+identities include the type, see typedCompositeID. Shared Jump Group entries don't.
+*/
+type jumpItemAssociationEntryResource[TApi api.APIResource, PT api.JumpItemAssociationEntryPtr[TApi], TTf any] struct {
+	apiResource[TApi, TTf]
+	parentAttr string
+	typeAttr   string
+	entryAttr  string
+}
+
+const jumpItemAssociationEntrySchemaVersion int64 = 1
+
+func jumpItemAssociationEntrySchema(description string, parentAttr string, parentDescription string, typeAttr string, entryAttr string, entryDescription string) schema.Schema {
+	attributes := map[string]schema.Attribute{}
+	idFormat := fmt.Sprintf("<%s>/<%s>", parentAttr, entryAttr)
+	if typeAttr != "" {
+		attributes[typeAttr] = jumpItemTypeSchema()
+		idFormat = fmt.Sprintf("<%s>/<%s>/<%s>", parentAttr, typeAttr, entryAttr)
+	}
+	attributes["id"] = schema.StringAttribute{
+		Description: "The ID of the association in the form " + idFormat,
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+	attributes[parentAttr] = schema.Int64Attribute{
+		Description: parentDescription,
+		Required:    true,
+		PlanModifiers: []planmodifier.Int64{
+			int64planmodifier.RequiresReplace(),
+		},
+	}
+	attributes[entryAttr] = schema.Int64Attribute{
+		Description: entryDescription,
+		Required:    true,
+		PlanModifiers: []planmodifier.Int64{
+			int64planmodifier.RequiresReplace(),
+		},
+	}
+
+	return schema.Schema{
+		Version: jumpItemAssociationEntrySchemaVersion,
+		MarkdownDescription: description + `
+
+The Jump Item association must have its ` + "`filter_type`" + ` set to "criteria". Don't also list the same entries in the inline ` + "`jump_item_association`" + ` attribute, or each will keep reverting the changes of the other.
+
+For descriptions of individual fields, please see the Configuration API documentation on your SRA Appliance`,
+		Attributes: attributes,
+	}
+}
+
+func (r *jumpItemAssociationEntryResource[TApi, PT, TTf]) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(jumpItemAssociationEntrySchemaVersion, nil)
+}
+
+// The type of the Jump Item being associated
+func jumpItemTypeSchema() schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: "The type of the Jump Item",
+		Required:    true,
+		Validators: []validator.String{
+			stringvalidator.OneOf([]string{"jump_client", "remote_rdp", "shell_jump", "web_jump", "protocol_tunnel"}...),
+		},
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+}
+
+func (r *jumpItemAssociationEntryResource[TApi, PT, TTf]) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	if r.typeAttr != "" {
+		resp.IdentitySchema = typedCompositeIdentitySchema(r.parentAttr, r.typeAttr, r.entryAttr)
+		return
+	}
+	resp.IdentitySchema = compositeIdentitySchema(r.parentAttr, r.entryAttr)
+}
+
+// Returns the ID of an entry, with the type for Jump Item entries
+func (r *jumpItemAssociationEntryResource[TApi, PT, TTf]) entryID(parentID int, entryType string, entryID int) string {
+	if r.typeAttr != "" {
+		return typedCompositeID(parentID, entryType, entryID)
+	}
+	return compositeID(parentID, entryID)
+}
+
+func (r *jumpItemAssociationEntryResource[TApi, PT, TTf]) parseEntryID(id string) (int, string, int, error) {
+	if r.typeAttr != "" {
+		return parseTypedCompositeID(id)
+	}
+	parentID, entryID, err := parseCompositeID(id)
+	return parentID, "", entryID, err
+}
+
+func (r *jumpItemAssociationEntryResource[TApi, PT, TTf]) setEntryIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, id types.String) diag.Diagnostics {
+	if r.typeAttr != "" {
+		return setTypedCompositeIdentity(ctx, identity, id, r.parentAttr, r.typeAttr, r.entryAttr)
+	}
+	return setCompositeIdentity(ctx, identity, id, r.parentAttr, r.entryAttr)
+}
+
+func (r *jumpItemAssociationEntryResource[TApi, PT, TTf]) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan TTf
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var item TApi
+	api.CopyTFtoAPI(ctx, reflect.ValueOf(&plan).Elem(), reflect.ValueOf(&item).Elem())
+
+	tflog.Debug(ctx, "🙀 adding jump item association entry", map[string]interface{}{
+		"endpoint": PT(&item).Endpoint(),
+		"entry":    PT(&item).EntryID(),
+	})
+	_, err := api.CreateItem(r.ApiClient, item)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error adding Jump Item association",
+			fmt.Sprintf("Unexpected error adding %s [%d] to the association of %s [%d]: %s", r.entryAttr, PT(&item).EntryID(), r.parentAttr, PT(&item).ParentID(), err.Error()),
+		)
+		return
+	}
+
+	var entryType types.String
+	if r.typeAttr != "" {
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root(r.typeAttr), &entryType)...)
+	}
+	id := types.StringValue(r.entryID(PT(&item).ParentID(), entryType.ValueString(), PT(&item).EntryID()))
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.setEntryIdentity(ctx, resp.Identity, id)...)
+}
+
+func (r *jumpItemAssociationEntryResource[TApi, PT, TTf]) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var id types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parentID, entryType, entryID, err := r.parseEntryID(id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading Jump Item association", err.Error())
+		return
+	}
+
+	// The type only matches entries of that type
+	current := req.State
+	if r.typeAttr != "" {
+		resp.Diagnostics.Append(current.SetAttribute(ctx, path.Root(r.typeAttr), entryType)...)
+	}
+	var state TTf
+	resp.Diagnostics.Append(current.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var item TApi
+	api.CopyTFtoAPI(ctx, reflect.ValueOf(&state).Elem(), reflect.ValueOf(&item).Elem())
+	PT(&item).SetEntry(parentID, entryID)
+
+	association, err := api.GetItemEndpoint[api.AccountJumpItemAssociation](r.ApiClient, PT(&item).AssociationEndpoint())
+	if api.IsNotFound(err) {
+		// Removing the association, or its Account, removes the entry with it
+		association = &api.AccountJumpItemAssociation{}
+	} else if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Jump Item association",
+			fmt.Sprintf("Unexpected error reading the association of %s [%d]: %s", r.parentAttr, parentID, err.Error()),
+		)
+		return
+	}
+
+	if !PT(&item).FindIn(*association) {
+		tflog.Debug(ctx, "🙀 entry is no longer part of the jump item association", map[string]interface{}{
+			r.parentAttr: parentID,
+			r.entryAttr:  entryID,
+		})
+		removeFromState(ctx, resp, r.setEntryIdentity(ctx, resp.Identity, id))
+		return
+	}
+
+	api.CopyAPItoTF(ctx, reflect.ValueOf(&item).Elem(), reflect.ValueOf(&state).Elem(), reflect.TypeOf(item))
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.setEntryIdentity(ctx, resp.Identity, id)...)
+}
+
+// Every attribute requires replacement, so there is nothing to send to the API here
+func (r *jumpItemAssociationEntryResource[TApi, PT, TTf]) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan TTf
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var id types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("id"), &id)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.setEntryIdentity(ctx, resp.Identity, id)...)
+}
+
+func (r *jumpItemAssociationEntryResource[TApi, PT, TTf]) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state TTf
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var item TApi
+	api.CopyTFtoAPI(ctx, reflect.ValueOf(&state).Elem(), reflect.ValueOf(&item).Elem())
+
+	err := api.DeleteItemBody(r.ApiClient, item)
+	if err != nil && !api.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error removing Jump Item association",
+			fmt.Sprintf("Unexpected error removing %s [%d] from the association of %s [%d]: %s", r.entryAttr, PT(&item).EntryID(), r.parentAttr, PT(&item).ParentID(), err.Error()),
+		)
+		return
+	}
+}
+
+// Imports using an ID in the form <parent_id>/<entry_id>, or <parent_id>/<type>/<entry_id> for Jump Items, or an
+// identity with the same attributes
+func (r *jumpItemAssociationEntryResource[TApi, PT, TTf]) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importID := req.ID
+	if importID == "" && req.Identity != nil {
+		var diags diag.Diagnostics
+		if r.typeAttr != "" {
+			importID, diags = typedCompositeIDFromIdentity(ctx, req.Identity, r.parentAttr, r.typeAttr, r.entryAttr)
+		} else {
+			importID, diags = compositeIDFromIdentity(ctx, req.Identity, r.parentAttr, r.entryAttr)
+		}
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	parentID, entryType, entryID, err := r.parseEntryID(importID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), r.entryID(parentID, entryType, entryID))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(r.parentAttr), int64(parentID))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(r.entryAttr), int64(entryID))...)
+	if r.typeAttr != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(r.typeAttr), entryType)...)
+	}
+}
@@ -0,0 +1,177 @@
+package rs
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"terraform-provider-sra/api"
+	"terraform-provider-sra/bt/models"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// Returns a client for a fake appliance with the given association, and the
+// list of requests that change it
+func testJumpItemAssociationClient(t *testing.T, association *string) (*api.APIClient, *[]string) {
+	requests := []string{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if strings.HasSuffix(r.URL.Path, "oauth2/token") {
+			_, err := w.Write([]byte(`{"token_type":"Bearer","expires_in":3600,"access_token":"secret_access_granted"}`))
+			assert.Nil(t, err)
+			return
+		}
+		endpoint := strings.TrimPrefix(r.URL.Path, "/api/config/v1/")
+		if r.Method == http.MethodGet {
+			if *association == "" {
+				w.WriteHeader(http.StatusNotFound)
+				_, err := w.Write([]byte(`{"message":"Not Found"}`))
+				assert.Nil(t, err)
+				return
+			}
+			_, err := w.Write([]byte(*association))
+			assert.Nil(t, err)
+			return
+		}
+
+		body, err := io.ReadAll(r.Body)
+		assert.Nil(t, err)
+		requests = append(requests, r.Method+" "+endpoint+" "+string(body))
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		_, err = w.Write([]byte(`{}`))
+		assert.Nil(t, err)
+	}))
+	t.Cleanup(ts.Close)
+
+	clientID := "id"
+	clientSecret := "🤐"
+	c, err := api.NewClient(ts.URL, &clientID, &clientSecret)
+	c.SetTest(t)
+	assert.Nil(t, err)
+
+	return c, &requests
+}
+
+func TestVaultAccountJumpItemAssociation(t *testing.T) {
+	ctx := context.Background()
+	association := `{"filter_type":"criteria","criteria":{"shared_jump_groups":[]},"jump_items":[{"id":12,"type":"shell_jump"}]}`
+	c, requests := testJumpItemAssociationClient(t, &association)
+
+	r := newVaultAccountJumpItemAssociationResource().(*vaultAccountJumpItemAssociationResource)
+	r.ApiClient = c
+
+	// Create adds only the single Jump Item
+	plan, _ := testState(t, r, map[string]any{
+		"account_id":     int64(21),
+		"jump_item_id":   int64(12),
+		"jump_item_type": "shell_jump",
+	})
+	state, identity := testState(t, r, nil)
+	createResp := resource.CreateResponse{State: state, Identity: identity}
+	r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw}}, &createResp)
+	assert.False(t, createResp.Diagnostics.HasError(), createResp.Diagnostics)
+	assert.Equal(t, []string{`POST vault/account/21/jump-item-association/jump-item {"id":12,"type":"shell_jump"}`}, *requests)
+
+	var created models.VaultAccountJumpItemAssociation
+	assert.False(t, createResp.State.Get(ctx, &created).HasError())
+	assert.Equal(t, "21/shell_jump/12", created.ID.ValueString())
+
+	var identityType types.String
+	createResp.Identity.GetAttribute(ctx, path.Root("jump_item_type"), &identityType)
+	assert.Equal(t, "shell_jump", identityType.ValueString())
+
+	// Jump Items of different types can share IDs, so imports need the type
+	state, identity = testState(t, r, nil)
+	importResp := resource.ImportStateResponse{State: state, Identity: identity}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: "21/12"}, &importResp)
+	assert.True(t, importResp.Diagnostics.HasError())
+
+	state, identity = testState(t, r, nil)
+	importResp = resource.ImportStateResponse{State: state, Identity: identity}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: "21/shell_jump/12"}, &importResp)
+	assert.False(t, importResp.Diagnostics.HasError(), importResp.Diagnostics)
+
+	readResp := resource.ReadResponse{State: importResp.State, Identity: importResp.Identity}
+	r.Read(ctx, resource.ReadRequest{State: importResp.State}, &readResp)
+	assert.False(t, readResp.Diagnostics.HasError(), readResp.Diagnostics)
+	var imported models.VaultAccountJumpItemAssociation
+	assert.False(t, readResp.State.Get(ctx, &imported).HasError())
+	assert.Equal(t, created, imported)
+
+	// Importing from the identity works the same way
+	state, _ = testState(t, r, nil)
+	importResp = resource.ImportStateResponse{State: state, Identity: createResp.Identity}
+	r.ImportState(ctx, resource.ImportStateRequest{Identity: createResp.Identity}, &importResp)
+	assert.False(t, importResp.Diagnostics.HasError(), importResp.Diagnostics)
+	readResp = resource.ReadResponse{State: importResp.State, Identity: importResp.Identity}
+	r.Read(ctx, resource.ReadRequest{State: importResp.State}, &readResp)
+	assert.False(t, readResp.Diagnostics.HasError(), readResp.Diagnostics)
+	assert.False(t, readResp.State.Get(ctx, &imported).HasError())
+	assert.Equal(t, created, imported)
+
+	// A Jump Item of another type with the same ID isn't a match
+	state, identity = testState(t, r, nil)
+	importResp = resource.ImportStateResponse{State: state, Identity: identity}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: "21/web_jump/12"}, &importResp)
+	assert.False(t, importResp.Diagnostics.HasError(), importResp.Diagnostics)
+	readResp = resource.ReadResponse{State: importResp.State, Identity: importResp.Identity}
+	r.Read(ctx, resource.ReadRequest{State: importResp.State}, &readResp)
+	assert.False(t, readResp.Diagnostics.HasError(), readResp.Diagnostics)
+	assert.True(t, readResp.State.Raw.IsNull())
+
+	// Delete sends the Jump Item in the body
+	*requests = nil
+	deleteResp := resource.DeleteResponse{State: createResp.State}
+	r.Delete(ctx, resource.DeleteRequest{State: createResp.State}, &deleteResp)
+	assert.False(t, deleteResp.Diagnostics.HasError(), deleteResp.Diagnostics)
+	assert.Equal(t, []string{`DELETE vault/account/21/jump-item-association/jump-item {"id":12,"type":"shell_jump"}`}, *requests)
+
+	// Entries removed elsewhere are dropped from state
+	for _, a := range []string{`{"filter_type":"criteria","jump_items":[{"id":12,"type":"web_jump"}]}`, ""} {
+		association = a
+		readResp = resource.ReadResponse{State: createResp.State, Identity: createResp.Identity}
+		r.Read(ctx, resource.ReadRequest{State: createResp.State}, &readResp)
+		assert.False(t, readResp.Diagnostics.HasError(), readResp.Diagnostics)
+		assert.True(t, readResp.State.Raw.IsNull(), a)
+	}
+}
+
+func TestVaultAccountGroupSharedJumpGroupAssociation(t *testing.T) {
+	ctx := context.Background()
+	association := `{"filter_type":"criteria","criteria":{"shared_jump_groups":[4,5]},"jump_items":[]}`
+	c, requests := testJumpItemAssociationClient(t, &association)
+
+	r := newVaultAccountGroupSharedJumpGroupAssociationResource().(*vaultAccountGroupSharedJumpGroupAssociationResource)
+	r.ApiClient = c
+
+	plan, _ := testState(t, r, map[string]any{
+		"account_group_id":     int64(3),
+		"shared_jump_group_id": int64(5),
+	})
+	state, identity := testState(t, r, nil)
+	createResp := resource.CreateResponse{State: state, Identity: identity}
+	r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw}}, &createResp)
+	assert.False(t, createResp.Diagnostics.HasError(), createResp.Diagnostics)
+	assert.Equal(t, []string{`POST vault/account-group/3/jump-item-association/shared-jump-group {"shared_jump_group_id":5}`}, *requests)
+
+	readResp := resource.ReadResponse{State: createResp.State, Identity: createResp.Identity}
+	r.Read(ctx, resource.ReadRequest{State: createResp.State}, &readResp)
+	assert.False(t, readResp.Diagnostics.HasError(), readResp.Diagnostics)
+	assert.False(t, readResp.State.Raw.IsNull())
+
+	association = `{"filter_type":"criteria","criteria":{"shared_jump_groups":[4]},"jump_items":[]}`
+	readResp = resource.ReadResponse{State: createResp.State, Identity: createResp.Identity}
+	r.Read(ctx, resource.ReadRequest{State: createResp.State}, &readResp)
+	assert.False(t, readResp.Diagnostics.HasError(), readResp.Diagnostics)
+	assert.True(t, readResp.State.Raw.IsNull())
+}
@@ -5,11 +5,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
-	"sync"
 	"terraform-provider-sra/api"
 	"terraform-provider-sra/bt/models"
 
-	mapset "github.com/deckarep/golang-set/v2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -30,14 +29,7 @@ var (
 	_ resource.Resource                = &vaultSSHAccountResource{}
 	_ resource.ResourceWithConfigure   = &vaultSSHAccountResource{}
 	_ resource.ResourceWithImportState = &vaultSSHAccountResource{}
-	// _ resource.ResourceWithModifyPlan  = &vaultSSHAccountResource{}
-
-	// Because of the way the PHP code handles changing memberships, those
-	// operations cannot be done in parallel. We use this mutex to ensure
-	// we deal with membership updates one at a time.
-	//
-	// This mutex is shared with vault_user_pass_account
-	accountMembershipMutex sync.Mutex
+	_ resource.ResourceWithModifyPlan  = &vaultSSHAccountResource{}
 )
 
 func newVaultSSHAccountResource() resource.Resource {
@@ -48,8 +40,11 @@ type vaultSSHAccountResource struct {
 	apiResource[api.VaultSSHAccount, models.VaultSSHAccount]
 }
 
+const vaultSSHAccountSchemaVersion int64 = 1
+
 func (r *vaultSSHAccountResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:     vaultSSHAccountSchemaVersion,
 		Description: "Manages a Vault SSH Account.\n\nFor descriptions of individual fields, please see the Configuration API documentation on your SRA Appliance",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -65,7 +60,13 @@ func (r *vaultSSHAccountResource) Schema(_ context.Context, _ resource.SchemaReq
 				Validators: []validator.String{
 					stringvalidator.OneOf([]string{"ssh", "ssh_ca"}...),
 				},
+				// The type is part of the resource identity, so it can't change in place
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
+			"adopt_existing":      adoptExistingSchema(),
+			"deletion_protection": deletionProtectionSchema(),
 			"name": schema.StringAttribute{
 				Required: true,
 			},
@@ -98,6 +99,22 @@ func (r *vaultSSHAccountResource) Schema(_ context.Context, _ resource.SchemaReq
 				Optional:  true,
 				Sensitive: true,
 			},
+			"private_key_wo": schema.StringAttribute{
+				Description: "Write-only alternative to private_key that is never stored in the plan or state. It is only sent when the account is created or private_key_wo_version changes. Requires Terraform 1.11 or later.",
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("private_key")),
+				},
+			},
+			"private_key_wo_version": schema.Int64Attribute{
+				Description: "Change this value to send a new private key from private_key_wo",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("private_key_wo")),
+				},
+			},
 			"private_key_passphrase": schema.StringAttribute{
 				Optional:  true,
 				Sensitive: true,
@@ -110,7 +127,8 @@ func (r *vaultSSHAccountResource) Schema(_ context.Context, _ resource.SchemaReq
 				Computed: true,
 			},
 
-			"jump_item_association": accountJumpItemAssociationSchema(),
+			"jump_item_association":        accountJumpItemAssociationSchema(),
+			"group_policy_membership_mode": groupPolicyMembershipModeSchema(),
 			"group_policy_memberships": schema.SetNestedAttribute{
 				Optional: true,
 				NestedObject: schema.NestedAttributeObject{
@@ -132,8 +150,30 @@ func (r *vaultSSHAccountResource) Schema(_ context.Context, _ resource.SchemaReq
 	}
 }
 
+func (r *vaultSSHAccountResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(vaultSSHAccountSchemaVersion, nil)
+}
+
+func (r *vaultSSHAccountResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	warnDeletionProtection(ctx, req, resp, r.printableName())
+	checkInlineMemberships(ctx, req.Plan, "account_id", "group_policy_vault_account", &resp.Diagnostics)
+}
+
 func (r *vaultSSHAccountResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// private_key_wo is sent through the same API field as private_key, but must not end up in the state
+	writeOnly, diags := writeOnlyValue(ctx, req.Config, req.Plan, nil, "private_key_wo")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !writeOnly.IsNull() {
+		resp.Diagnostics.Append(req.Plan.SetAttribute(ctx, path.Root("private_key"), writeOnly)...)
+	}
+
 	r.apiResource.Create(ctx, req, resp)
+	if !writeOnly.IsNull() && !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("private_key"), types.StringNull())...)
+	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -219,50 +259,13 @@ func (r *vaultSSHAccountResource) Create(ctx context.Context, req resource.Creat
 			return
 		}
 
-		setGPList := mapset.NewSet(gpList...)
-
-		tflog.Trace(ctx, "🌈 Adding group policy memberships", map[string]interface{}{
-			"add": setGPList,
-
-			"tf":   tfGPList,
-			"list": gpList,
-		})
-
-		accountMembershipMutex.Lock()
-		defer accountMembershipMutex.Unlock()
-
-		results := []api.GroupPolicyVaultAccount{}
-		needsProvision := mapset.NewSet[string]()
-		for m := range setGPList.Iterator().C {
-			m.AccountID = &id
-			item, err := api.CreateItem(r.ApiClient, m)
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					"Error updating item's group policy memberships",
-					"Unexpected adding membership of item ID ["+strconv.Itoa(id)+"]: "+err.Error(),
-				)
-				return
-			}
-
-			item.GroupPolicyID = m.GroupPolicyID
-			results = append(results, *item)
-			needsProvision.Add(*m.GroupPolicyID)
-		}
-
-		for id := range needsProvision.Iter() {
-			p := api.GroupPolicyProvision{
-				GroupPolicyID: &id,
-			}
-			_, err := api.CreateItem(r.ApiClient, p)
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					"Error provisioning item's group policy memberships",
-					"Unexpected response provisioning membership of item ID ["+*p.GroupPolicyID+"]: "+err.Error(),
-				)
-				return
-			}
+		results, err := applyGroupPolicyMemberships[api.GroupPolicyVaultAccount](ctx, r.ApiClient, id, gpList, nil)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error adding item's group policy memberships",
+				"Unexpected adding memberships of item ID ["+strconv.Itoa(id)+"]: "+err.Error(),
+			)
+			return
 		}
 
 		diags = resp.State.SetAttribute(ctx, path.Root("group_policy_memberships"), results)
@@ -277,7 +280,7 @@ func (r *vaultSSHAccountResource) Create(ctx context.Context, req resource.Creat
 
 func (r *vaultSSHAccountResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	r.apiResource.Read(ctx, req, resp)
-	if resp.Diagnostics.HasError() {
+	if resp.Diagnostics.HasError() || resourceGone(resp) {
 		return
 	}
 	tflog.Debug(ctx, "🤬 SSH reading state")
@@ -346,63 +349,23 @@ func (r *vaultSSHAccountResource) Read(ctx context.Context, req resource.ReadReq
 
 	readJIA()
 
-	readGP := func() {
-		// Group Policy Memberships
-
-		var tfGPList types.Set
-		diags := req.State.GetAttribute(ctx, path.Root("group_policy_memberships"), &tfGPList)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-
-		if tfGPList.IsNull() {
-			return
-		}
-
-		var gpList []api.GroupPolicyVaultAccount
-		diags = tfGPList.ElementsAs(ctx, &gpList, false)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-
-		for i, m := range gpList {
-			m.AccountID = &id
-			tflog.Trace(ctx, "🌈 Reading item", map[string]interface{}{
-				"read": m,
-			})
-			gpId := *m.GroupPolicyID
-
-			endpoint := fmt.Sprintf("%s/%d", m.Endpoint(), id)
-			item, err := api.GetItemEndpoint[api.GroupPolicyVaultAccount](r.ApiClient, endpoint)
-
-			if err != nil {
-				tflog.Trace(ctx, "🌈 Error reading item item, skipping", map[string]interface{}{
-					"read":  m,
-					"error": err,
-				})
-			} else if item != nil {
-				tflog.Trace(ctx, "🌈 Read item", map[string]interface{}{
-					"read": *item,
-				})
-				item.GroupPolicyID = &gpId
-				gpList[i] = *item
-			}
-		}
-
-		diags = resp.State.SetAttribute(ctx, path.Root("group_policy_memberships"), gpList)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-	}
-
-	readGP()
+	readGroupPolicyMemberships[api.GroupPolicyVaultAccount](ctx, r.ApiClient, req.State, resp, id)
 }
 
 func (r *vaultSSHAccountResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	writeOnly, diags := writeOnlyValue(ctx, req.Config, req.Plan, &req.State, "private_key_wo")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !writeOnly.IsNull() {
+		resp.Diagnostics.Append(req.Plan.SetAttribute(ctx, path.Root("private_key"), writeOnly)...)
+	}
+
 	r.apiResource.Update(ctx, req, resp)
+	if !writeOnly.IsNull() && !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("private_key"), types.StringNull())...)
+	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -440,6 +403,14 @@ func (r *vaultSSHAccountResource) Update(ctx context.Context, req resource.Updat
 		}
 		stateIsGone := tfStateObj.IsNull() || tfStateObj.IsUnknown()
 
+		if tfObj.IsUnknown() {
+			// Not configured, so the association is left as it is. Its entries may be managed
+			// by the standalone Jump Item association resources
+			diags = resp.State.SetAttribute(ctx, path.Root("jump_item_association"), tfStateObj)
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+
 		apiSub.ID = &id
 		tflog.Debug(ctx, fmt.Sprintf("🤷🏻‍♂️ Updating SSH Jump Associations with ID %d [%s]", *apiSub.ID, apiSub.Endpoint()), map[string]interface{}{
 			"data":           apiSub,
@@ -533,85 +504,20 @@ func (r *vaultSSHAccountResource) Update(ctx context.Context, req resource.Updat
 			return
 		}
 
-		toAdd, toRemove, noChange := api.DiffGPAccountLists(gpList, stateGPList)
-
-		tflog.Trace(ctx, "🌈 Updating group policy memberships", map[string]interface{}{
-			"add":      fmt.Sprintf("%+v", toAdd),
-			"remove":   fmt.Sprintf("%+v", toRemove),
-			"noChange": fmt.Sprintf("%+v", noChange),
-
-			"tfPlan":    fmt.Sprintf("%+v", tfGPList),
-			"tfState":   fmt.Sprintf("%+v", tfGPStateList),
-			"planList":  fmt.Sprintf("%+v", gpList),
-			"stateList": fmt.Sprintf("%+v", stateGPList),
-		})
-
-		accountMembershipMutex.Lock()
-		defer accountMembershipMutex.Unlock()
-
-		needsProvision := mapset.NewSet[string]()
-		for m := range toRemove.Iterator().C {
-			m.AccountID = &id
-			tflog.Trace(ctx, "🌈 Deleting item", map[string]interface{}{
-				"add":     m,
-				"gp":      *m.GroupPolicyID,
-				"account": m.AccountID,
-			})
-			endpoint := fmt.Sprintf("%s/%d", m.Endpoint(), *m.AccountID)
-			err := api.DeleteItemEndpoint[api.GroupPolicyVaultAccount](r.ApiClient, endpoint)
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					"Error updating item's group policy memberships",
-					"Unexpected deleting membership of item ID ["+strconv.Itoa(id)+"]: "+err.Error(),
-				)
-				return
-			}
-			needsProvision.Add(*m.GroupPolicyID)
-		}
-
-		results := noChange.ToSlice()
-		for m := range toAdd.Iterator().C {
-			m.AccountID = &id
-			tflog.Trace(ctx, "🌈 Adding item", map[string]interface{}{
-				"add":     m,
-				"gp":      *m.GroupPolicyID,
-				"account": m.AccountID,
-			})
-			item, err := api.CreateItem(r.ApiClient, m)
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					"Error updating item's group policy memberships",
-					"Unexpected adding membership of item ID ["+strconv.Itoa(id)+"]: "+err.Error(),
-				)
-				return
-			}
-			item.GroupPolicyID = m.GroupPolicyID
-			results = append(results, *item)
-			needsProvision.Add(*m.GroupPolicyID)
-		}
-
-		for id := range needsProvision.Iter() {
-			p := api.GroupPolicyProvision{
-				GroupPolicyID: &id,
-			}
-			_, err := api.CreateItem(r.ApiClient, p)
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					"Error provisioning item's group policy memberships",
-					"Unexpected response provisioning membership of item ID ["+*p.GroupPolicyID+"]: "+err.Error(),
-				)
-				return
-			}
+		results, err := applyGroupPolicyMemberships[api.GroupPolicyVaultAccount](ctx, r.ApiClient, id, gpList, stateGPList)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating item's group policy memberships",
+				"Unexpected updating memberships of item ID ["+strconv.Itoa(id)+"]: "+err.Error(),
+			)
+			return
 		}
 
 		tflog.Trace(ctx, "🌈 Updating state with results", map[string]interface{}{
 			"results": fmt.Sprintf("%+v", results),
 		})
 
-		diags = resp.State.SetAttribute(ctx, path.Root("group_policy_memberships"), results)
+		diags = setGroupPolicyMemberships(ctx, tfGPList, &resp.State, results)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
 			return
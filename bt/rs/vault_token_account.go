@@ -8,7 +8,7 @@ import (
 	"terraform-provider-sra/api"
 	"terraform-provider-sra/bt/models"
 
-	mapset "github.com/deckarep/golang-set/v2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -29,7 +29,7 @@ var (
 	_ resource.Resource                = &vaultTokenAccountResource{}
 	_ resource.ResourceWithConfigure   = &vaultTokenAccountResource{}
 	_ resource.ResourceWithImportState = &vaultTokenAccountResource{}
-	// _ resource.ResourceWithModifyPlan  = &vaultTokenAccountResource{}
+	_ resource.ResourceWithModifyPlan  = &vaultTokenAccountResource{}
 )
 
 func newVaultTokenAccountResource() resource.Resource {
@@ -40,8 +40,11 @@ type vaultTokenAccountResource struct {
 	apiResource[api.VaultTokenAccount, models.VaultTokenAccount]
 }
 
+const vaultTokenAccountSchemaVersion int64 = 1
+
 func (r *vaultTokenAccountResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:     vaultTokenAccountSchemaVersion,
 		Description: "Manages a Vault Token Account.\n\nFor descriptions of individual fields, please see the Configuration API documentation on your SRA Appliance",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -54,6 +57,8 @@ func (r *vaultTokenAccountResource) Schema(_ context.Context, _ resource.SchemaR
 				Computed: true,
 				Default:  stringdefault.StaticString("opaque_token"),
 			},
+			"adopt_existing":      adoptExistingSchema(),
+			"deletion_protection": deletionProtectionSchema(),
 			"name": schema.StringAttribute{
 				Required: true,
 			},
@@ -77,14 +82,34 @@ func (r *vaultTokenAccountResource) Schema(_ context.Context, _ resource.SchemaR
 				Optional: true,
 			},
 			"token": schema.StringAttribute{
-				Required:  true,
+				Optional:  true,
 				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("token_wo")),
+				},
+			},
+			"token_wo": schema.StringAttribute{
+				Description: "Write-only alternative to token that is never stored in the plan or state. It is only sent when the account is created or token_wo_version changes. Requires Terraform 1.11 or later.",
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("token")),
+				},
+			},
+			"token_wo_version": schema.Int64Attribute{
+				Description: "Change this value to send a new token from token_wo",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("token_wo")),
+				},
 			},
 			"last_checkout_timestamp": schema.StringAttribute{
 				Computed: true,
 			},
 
-			"jump_item_association": accountJumpItemAssociationSchema(),
+			"jump_item_association":        accountJumpItemAssociationSchema(),
+			"group_policy_membership_mode": groupPolicyMembershipModeSchema(),
 			"group_policy_memberships": schema.SetNestedAttribute{
 				Optional: true,
 				NestedObject: schema.NestedAttributeObject{
@@ -106,8 +131,30 @@ func (r *vaultTokenAccountResource) Schema(_ context.Context, _ resource.SchemaR
 	}
 }
 
+func (r *vaultTokenAccountResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(vaultTokenAccountSchemaVersion, nil)
+}
+
+func (r *vaultTokenAccountResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	warnDeletionProtection(ctx, req, resp, r.printableName())
+	checkInlineMemberships(ctx, req.Plan, "account_id", "group_policy_vault_account", &resp.Diagnostics)
+}
+
 func (r *vaultTokenAccountResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// token_wo is sent through the same API field as token, but must not end up in the state
+	writeOnly, diags := writeOnlyValue(ctx, req.Config, req.Plan, nil, "token_wo")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !writeOnly.IsNull() {
+		resp.Diagnostics.Append(req.Plan.SetAttribute(ctx, path.Root("token"), writeOnly)...)
+	}
+
 	r.apiResource.Create(ctx, req, resp)
+	if !writeOnly.IsNull() && !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("token"), types.StringNull())...)
+	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -192,51 +239,13 @@ func (r *vaultTokenAccountResource) Create(ctx context.Context, req resource.Cre
 			return
 		}
 
-		setGPList := mapset.NewSet(gpList...)
-
-		tflog.Trace(ctx, "🌈 Adding group policy memberships", map[string]interface{}{
-			"add": setGPList,
-
-			"tf":   tfGPList,
-			"list": gpList,
-		})
-
-		// Shared with vault_ssh_account
-		accountMembershipMutex.Lock()
-		defer accountMembershipMutex.Unlock()
-
-		results := []api.GroupPolicyVaultAccount{}
-		needsProvision := mapset.NewSet[string]()
-		for m := range setGPList.Iterator().C {
-			m.AccountID = &id
-			item, err := api.CreateItem(r.ApiClient, m)
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					"Error updating item's group policy memberships",
-					"Unexpected adding membership of item ID ["+strconv.Itoa(id)+"]: "+err.Error(),
-				)
-				return
-			}
-
-			item.GroupPolicyID = m.GroupPolicyID
-			results = append(results, *item)
-			needsProvision.Add(*m.GroupPolicyID)
-		}
-
-		for id := range needsProvision.Iter() {
-			p := api.GroupPolicyProvision{
-				GroupPolicyID: &id,
-			}
-			_, err := api.CreateItem(r.ApiClient, p)
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					"Error provisioning item's group policy memberships",
-					"Unexpected response provisioning membership of item ID ["+*p.GroupPolicyID+"]: "+err.Error(),
-				)
-				return
-			}
+		results, err := applyGroupPolicyMemberships[api.GroupPolicyVaultAccount](ctx, r.ApiClient, id, gpList, nil)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error adding item's group policy memberships",
+				"Unexpected adding memberships of item ID ["+strconv.Itoa(id)+"]: "+err.Error(),
+			)
+			return
 		}
 
 		diags = resp.State.SetAttribute(ctx, path.Root("group_policy_memberships"), results)
@@ -251,7 +260,7 @@ func (r *vaultTokenAccountResource) Create(ctx context.Context, req resource.Cre
 
 func (r *vaultTokenAccountResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	r.apiResource.Read(ctx, req, resp)
-	if resp.Diagnostics.HasError() {
+	if resp.Diagnostics.HasError() || resourceGone(resp) {
 		return
 	}
 	tflog.Debug(ctx, "🤬 Token reading state")
@@ -321,63 +330,23 @@ func (r *vaultTokenAccountResource) Read(ctx context.Context, req resource.ReadR
 
 	readJIA()
 
-	readGP := func() {
-		// Group Policy Memberships
-
-		var tfGPList types.Set
-		diags := req.State.GetAttribute(ctx, path.Root("group_policy_memberships"), &tfGPList)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-
-		if tfGPList.IsNull() {
-			return
-		}
-
-		var gpList []api.GroupPolicyVaultAccount
-		diags = tfGPList.ElementsAs(ctx, &gpList, false)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-
-		for i, m := range gpList {
-			m.AccountID = &id
-			tflog.Trace(ctx, "🌈 Reading item", map[string]interface{}{
-				"read": m,
-			})
-			gpId := *m.GroupPolicyID
-
-			endpoint := fmt.Sprintf("%s/%d", m.Endpoint(), id)
-			item, err := api.GetItemEndpoint[api.GroupPolicyVaultAccount](r.ApiClient, endpoint)
-
-			if err != nil {
-				tflog.Trace(ctx, "🌈 Error reading item item, skipping", map[string]interface{}{
-					"read":  m,
-					"error": err,
-				})
-			} else if item != nil {
-				tflog.Trace(ctx, "🌈 Read item", map[string]interface{}{
-					"read": *item,
-				})
-				item.GroupPolicyID = &gpId
-				gpList[i] = *item
-			}
-		}
-
-		diags = resp.State.SetAttribute(ctx, path.Root("group_policy_memberships"), gpList)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-	}
-
-	readGP()
+	readGroupPolicyMemberships[api.GroupPolicyVaultAccount](ctx, r.ApiClient, req.State, resp, id)
 }
 
 func (r *vaultTokenAccountResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	writeOnly, diags := writeOnlyValue(ctx, req.Config, req.Plan, &req.State, "token_wo")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !writeOnly.IsNull() {
+		resp.Diagnostics.Append(req.Plan.SetAttribute(ctx, path.Root("token"), writeOnly)...)
+	}
+
 	r.apiResource.Update(ctx, req, resp)
+	if !writeOnly.IsNull() && !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("token"), types.StringNull())...)
+	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -414,6 +383,14 @@ func (r *vaultTokenAccountResource) Update(ctx context.Context, req resource.Upd
 		}
 		stateIsGone := tfStateObj.IsNull() || tfStateObj.IsUnknown()
 
+		if tfObj.IsUnknown() {
+			// Not configured, so the association is left as it is. Its entries may be managed
+			// by the standalone Jump Item association resources
+			diags = resp.State.SetAttribute(ctx, path.Root("jump_item_association"), tfStateObj)
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+
 		apiSub.ID = &id
 		tflog.Debug(ctx, fmt.Sprintf("🤷🏻‍♂️ Updating Token Jump Associations with ID %d [%s]", *apiSub.ID, apiSub.Endpoint()), map[string]interface{}{
 			"data":           apiSub,
@@ -507,75 +484,16 @@ func (r *vaultTokenAccountResource) Update(ctx context.Context, req resource.Upd
 			return
 		}
 
-		toAdd, toRemove, noChange := api.DiffGPAccountLists(gpList, stateGPList)
-
-		tflog.Trace(ctx, "🌈 Updating group policy memberships", map[string]interface{}{
-			"add":    toAdd,
-			"remove": toRemove,
-
-			"tf":    tfGPList,
-			"list":  gpList,
-			"state": stateGPList,
-		})
-
-		// Shared with vault_ssh_account
-		accountMembershipMutex.Lock()
-		defer accountMembershipMutex.Unlock()
-
-		needsProvision := mapset.NewSet[string]()
-		for m := range toRemove.Iterator().C {
-			m.AccountID = &id
-			tflog.Trace(ctx, "🌈 Deleting item", map[string]interface{}{
-				"add":     m,
-				"gp":      m.GroupPolicyID,
-				"account": m.AccountID,
-			})
-			endpoint := fmt.Sprintf("%s/%d", m.Endpoint(), *m.AccountID)
-			err := api.DeleteItemEndpoint[api.GroupPolicyVaultAccount](r.ApiClient, endpoint)
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					"Error updating item's group policy memberships",
-					"Unexpected deleting membership of item ID ["+strconv.Itoa(id)+"]: "+err.Error(),
-				)
-				return
-			}
-			needsProvision.Add(*m.GroupPolicyID)
-		}
-
-		results := noChange.ToSlice()
-		for m := range toAdd.Iterator().C {
-			m.AccountID = &id
-			item, err := api.CreateItem(r.ApiClient, m)
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					"Error updating item's group policy memberships",
-					"Unexpected adding membership of item ID ["+strconv.Itoa(id)+"]: "+err.Error(),
-				)
-				return
-			}
-			item.GroupPolicyID = m.GroupPolicyID
-			results = append(results, *item)
-			needsProvision.Add(*m.GroupPolicyID)
-		}
-
-		for id := range needsProvision.Iter() {
-			p := api.GroupPolicyProvision{
-				GroupPolicyID: &id,
-			}
-			_, err := api.CreateItem(r.ApiClient, p)
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					"Error provisioning item's group policy memberships",
-					"Unexpected response provisioning membership of item ID ["+*p.GroupPolicyID+"]: "+err.Error(),
-				)
-				return
-			}
+		results, err := applyGroupPolicyMemberships[api.GroupPolicyVaultAccount](ctx, r.ApiClient, id, gpList, stateGPList)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating item's group policy memberships",
+				"Unexpected updating memberships of item ID ["+strconv.Itoa(id)+"]: "+err.Error(),
+			)
+			return
 		}
 
-		diags = resp.State.SetAttribute(ctx, path.Root("group_policy_memberships"), results)
+		diags = setGroupPolicyMemberships(ctx, tfGPList, &resp.State, results)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
 			return
@@ -0,0 +1,244 @@
+package rs
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"terraform-provider-sra/api"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+/*
+The Vault user membership resources manage a direct user membership of a Vault Account or Account Group. Both
+work the same way and only differ in the API type and the attribute holding the ID of the parent, so the CRUD
+is shared here and each resource only supplies its schema description.
+
+The TF and API models use the same field names, so they are copied with api.CopyTFtoAPI and api.CopyAPItoTF.
+*/
+type vaultUserMembershipResource[TApi api.APIResource, PT api.VaultUserMembershipPtr[TApi], TTf any] struct {
+	apiResource[TApi, TTf]
+	parentAttr string
+	parentName string
+}
+
+const vaultUserMembershipSchemaVersion int64 = 1
+
+func vaultUserMembershipSchema(description string, parentAttr string) schema.Schema {
+	return schema.Schema{
+		Version: vaultUserMembershipSchemaVersion,
+		MarkdownDescription: description + `
+
+For descriptions of individual fields, please see the Configuration API documentation on your SRA Appliance`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: fmt.Sprintf("The ID of the membership in the form <%s>/<user_id>", parentAttr),
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			parentAttr: schema.Int64Attribute{
+				Required: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"user_id": schema.Int64Attribute{
+				Required: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"role": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("inject"),
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{"inject", "inject_and_checkout"}...),
+				},
+			},
+		},
+	}
+}
+
+func (r *vaultUserMembershipResource[TApi, PT, TTf]) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(vaultUserMembershipSchemaVersion, nil)
+}
+
+func (r *vaultUserMembershipResource[TApi, PT, TTf]) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = compositeIdentitySchema(r.parentAttr, "user_id")
+}
+
+// Single memberships are read, updated and removed at the endpoint of the user within the parent
+func (r *vaultUserMembershipResource[TApi, PT, TTf]) membershipEndpoint(item *TApi) string {
+	_, userID := PT(item).MembershipIDs()
+	return fmt.Sprintf("%s/%d", PT(item).Endpoint(), userID)
+}
+
+func (r *vaultUserMembershipResource[TApi, PT, TTf]) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan TTf
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var item TApi
+	api.CopyTFtoAPI(ctx, reflect.ValueOf(&plan).Elem(), reflect.ValueOf(&item).Elem())
+	parentID, userID := PT(&item).MembershipIDs()
+
+	tflog.Debug(ctx, "🙀 adding vault user membership", map[string]interface{}{
+		r.parentAttr: parentID,
+		"user_id":    userID,
+	})
+	_, err := api.CreateItem(r.ApiClient, item)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error adding "+r.parentName+" user",
+			fmt.Sprintf("Unexpected error adding user [%d] to %s [%d]: %s", userID, r.parentAttr, parentID, err.Error()),
+		)
+		return
+	}
+
+	id := types.StringValue(compositeID(parentID, userID))
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setCompositeIdentity(ctx, resp.Identity, id, r.parentAttr, "user_id")...)
+}
+
+func (r *vaultUserMembershipResource[TApi, PT, TTf]) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state TTf
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	var id types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parentID, userID, err := parseCompositeID(id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading "+r.parentName+" user", err.Error())
+		return
+	}
+
+	var tmp TApi
+	PT(&tmp).SetMembershipIDs(parentID, userID)
+	item, err := api.GetItemEndpoint[TApi](r.ApiClient, r.membershipEndpoint(&tmp))
+	if api.IsNotFound(err) {
+		tflog.Warn(ctx, "🙀 membership no longer exists, removing it from state", map[string]interface{}{
+			"id": id.ValueString(),
+		})
+		removeFromState(ctx, resp, setCompositeIdentity(ctx, resp.Identity, id, r.parentAttr, "user_id"))
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading "+r.parentName+" user",
+			fmt.Sprintf("Unexpected error reading user [%d] of %s [%d]: %s", userID, r.parentAttr, parentID, err.Error()),
+		)
+		return
+	}
+
+	// The parent ID isn't part of the response
+	PT(item).SetMembershipIDs(parentID, userID)
+	api.CopyAPItoTF(ctx, reflect.ValueOf(item).Elem(), reflect.ValueOf(&state).Elem(), reflect.TypeOf(*item))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setCompositeIdentity(ctx, resp.Identity, id, r.parentAttr, "user_id")...)
+}
+
+func (r *vaultUserMembershipResource[TApi, PT, TTf]) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan TTf
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	var id types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("id"), &id)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var item TApi
+	api.CopyTFtoAPI(ctx, reflect.ValueOf(&plan).Elem(), reflect.ValueOf(&item).Elem())
+	parentID, userID := PT(&item).MembershipIDs()
+
+	_, err := api.UpdateItemEndpoint(r.ApiClient, item, r.membershipEndpoint(&item))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating "+r.parentName+" user",
+			fmt.Sprintf("Unexpected error updating user [%d] of %s [%d]: %s", userID, r.parentAttr, parentID, err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setCompositeIdentity(ctx, resp.Identity, id, r.parentAttr, "user_id")...)
+}
+
+func (r *vaultUserMembershipResource[TApi, PT, TTf]) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state TTf
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var item TApi
+	api.CopyTFtoAPI(ctx, reflect.ValueOf(&state).Elem(), reflect.ValueOf(&item).Elem())
+	parentID, userID := PT(&item).MembershipIDs()
+
+	err := api.DeleteItemEndpoint[TApi](r.ApiClient, r.membershipEndpoint(&item))
+	if api.IsNotFound(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error removing "+r.parentName+" user",
+			fmt.Sprintf("Unexpected error removing user [%d] from %s [%d]: %s", userID, r.parentAttr, parentID, err.Error()),
+		)
+		return
+	}
+}
+
+// Imports using an ID in the form <parent_id>/<user_id>, or an identity with both IDs
+func (r *vaultUserMembershipResource[TApi, PT, TTf]) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importID := req.ID
+	if importID == "" && req.Identity != nil {
+		var diags diag.Diagnostics
+		importID, diags = compositeIDFromIdentity(ctx, req.Identity, r.parentAttr, "user_id")
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	parentID, userID, err := parseCompositeID(importID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), compositeID(parentID, userID))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(r.parentAttr), int64(parentID))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), int64(userID))...)
+}
@@ -0,0 +1,85 @@
+package rs
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"terraform-provider-sra/api"
+	"terraform-provider-sra/bt/models"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVaultUserMembership(t *testing.T) {
+	ctx := context.Background()
+	requests := []string{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if strings.HasSuffix(r.URL.Path, "oauth2/token") {
+			_, err := w.Write([]byte(`{"token_type":"Bearer","expires_in":3600,"access_token":"secret_access_granted"}`))
+			assert.Nil(t, err)
+			return
+		}
+		body, err := io.ReadAll(r.Body)
+		assert.Nil(t, err)
+		requests = append(requests, strings.TrimSpace(r.Method+" "+strings.TrimPrefix(r.URL.Path, "/api/config/v1/")+" "+string(body)))
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		_, err = w.Write([]byte(`{"user_id":5,"role":"inject_and_checkout"}`))
+		assert.Nil(t, err)
+	}))
+	defer ts.Close()
+
+	clientID := "id"
+	clientSecret := "🤐"
+	c, err := api.NewClient(ts.URL, &clientID, &clientSecret)
+	c.SetTest(t)
+	assert.Nil(t, err)
+
+	r := newVaultAccountGroupUserResource().(*vaultAccountGroupUserResource)
+	r.ApiClient = c
+
+	plan, _ := testState(t, r, map[string]any{
+		"account_group_id": int64(3),
+		"user_id":          int64(5),
+		"role":             "inject_and_checkout",
+	})
+	state, identity := testState(t, r, nil)
+	createResp := resource.CreateResponse{State: state, Identity: identity}
+	r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw}}, &createResp)
+	assert.False(t, createResp.Diagnostics.HasError(), createResp.Diagnostics)
+
+	var created models.VaultAccountGroupUser
+	assert.False(t, createResp.State.Get(ctx, &created).HasError())
+	assert.Equal(t, "3/5", created.ID.ValueString())
+
+	// Imports only know the IDs, the role is read from the membership
+	state, identity = testState(t, r, nil)
+	importResp := resource.ImportStateResponse{State: state, Identity: identity}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: "3/5"}, &importResp)
+	assert.False(t, importResp.Diagnostics.HasError(), importResp.Diagnostics)
+
+	readResp := resource.ReadResponse{State: importResp.State, Identity: importResp.Identity}
+	r.Read(ctx, resource.ReadRequest{State: importResp.State}, &readResp)
+	assert.False(t, readResp.Diagnostics.HasError(), readResp.Diagnostics)
+	var imported models.VaultAccountGroupUser
+	assert.False(t, readResp.State.Get(ctx, &imported).HasError())
+	assert.Equal(t, created, imported)
+
+	deleteResp := resource.DeleteResponse{State: readResp.State}
+	r.Delete(ctx, resource.DeleteRequest{State: readResp.State}, &deleteResp)
+	assert.False(t, deleteResp.Diagnostics.HasError(), deleteResp.Diagnostics)
+
+	assert.Equal(t, []string{
+		`POST vault/account-group/3/user {"user_id":5,"role":"inject_and_checkout"}`,
+		"GET vault/account-group/3/user/5",
+		"DELETE vault/account-group/3/user/5",
+	}, requests)
+}
@@ -8,7 +8,7 @@ import (
 	"terraform-provider-sra/api"
 	"terraform-provider-sra/bt/models"
 
-	mapset "github.com/deckarep/golang-set/v2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -29,7 +29,7 @@ var (
 	_ resource.Resource                = &vaultUsernamePasswordAccountResource{}
 	_ resource.ResourceWithConfigure   = &vaultUsernamePasswordAccountResource{}
 	_ resource.ResourceWithImportState = &vaultUsernamePasswordAccountResource{}
-	// _ resource.ResourceWithModifyPlan  = &vaultUsernamePasswordAccountResource{}
+	_ resource.ResourceWithModifyPlan  = &vaultUsernamePasswordAccountResource{}
 )
 
 func newVaultUsernamePasswordAccountResource() resource.Resource {
@@ -40,8 +40,11 @@ type vaultUsernamePasswordAccountResource struct {
 	apiResource[api.VaultUsernamePasswordAccount, models.VaultUsernamePasswordAccount]
 }
 
+const vaultUsernamePasswordAccountSchemaVersion int64 = 1
+
 func (r *vaultUsernamePasswordAccountResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:     vaultUsernamePasswordAccountSchemaVersion,
 		Description: "Manages a Vault Username/Password Account.\n\nFor descriptions of individual fields, please see the Configuration API documentation on your SRA Appliance",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -54,6 +57,8 @@ func (r *vaultUsernamePasswordAccountResource) Schema(_ context.Context, _ resou
 				Computed: true,
 				Default:  stringdefault.StaticString("username_password"),
 			},
+			"adopt_existing":      adoptExistingSchema(),
+			"deletion_protection": deletionProtectionSchema(),
 			"name": schema.StringAttribute{
 				Required: true,
 			},
@@ -80,14 +85,34 @@ func (r *vaultUsernamePasswordAccountResource) Schema(_ context.Context, _ resou
 				Required: true,
 			},
 			"password": schema.StringAttribute{
-				Required:  true,
+				Optional:  true,
 				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("password_wo")),
+				},
+			},
+			"password_wo": schema.StringAttribute{
+				Description: "Write-only alternative to password that is never stored in the plan or state. It is only sent when the account is created or password_wo_version changes. Requires Terraform 1.11 or later.",
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("password")),
+				},
+			},
+			"password_wo_version": schema.Int64Attribute{
+				Description: "Change this value to send a new password from password_wo",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("password_wo")),
+				},
 			},
 			"last_checkout_timestamp": schema.StringAttribute{
 				Computed: true,
 			},
 
-			"jump_item_association": accountJumpItemAssociationSchema(),
+			"jump_item_association":        accountJumpItemAssociationSchema(),
+			"group_policy_membership_mode": groupPolicyMembershipModeSchema(),
 			"group_policy_memberships": schema.SetNestedAttribute{
 				Optional: true,
 				NestedObject: schema.NestedAttributeObject{
@@ -109,8 +134,30 @@ func (r *vaultUsernamePasswordAccountResource) Schema(_ context.Context, _ resou
 	}
 }
 
+func (r *vaultUsernamePasswordAccountResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(vaultUsernamePasswordAccountSchemaVersion, nil)
+}
+
+func (r *vaultUsernamePasswordAccountResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	warnDeletionProtection(ctx, req, resp, r.printableName())
+	checkInlineMemberships(ctx, req.Plan, "account_id", "group_policy_vault_account", &resp.Diagnostics)
+}
+
 func (r *vaultUsernamePasswordAccountResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// password_wo is sent through the same API field as password, but must not end up in the state
+	writeOnly, diags := writeOnlyValue(ctx, req.Config, req.Plan, nil, "password_wo")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !writeOnly.IsNull() {
+		resp.Diagnostics.Append(req.Plan.SetAttribute(ctx, path.Root("password"), writeOnly)...)
+	}
+
 	r.apiResource.Create(ctx, req, resp)
+	if !writeOnly.IsNull() && !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("password"), types.StringNull())...)
+	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -195,51 +242,13 @@ func (r *vaultUsernamePasswordAccountResource) Create(ctx context.Context, req r
 			return
 		}
 
-		setGPList := mapset.NewSet(gpList...)
-
-		tflog.Trace(ctx, "🌈 Adding group policy memberships", map[string]interface{}{
-			"add": setGPList,
-
-			"tf":   tfGPList,
-			"list": gpList,
-		})
-
-		// Shared with vault_ssh_account
-		accountMembershipMutex.Lock()
-		defer accountMembershipMutex.Unlock()
-
-		results := []api.GroupPolicyVaultAccount{}
-		needsProvision := mapset.NewSet[string]()
-		for m := range setGPList.Iterator().C {
-			m.AccountID = &id
-			item, err := api.CreateItem(r.ApiClient, m)
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					"Error updating item's group policy memberships",
-					"Unexpected adding membership of item ID ["+strconv.Itoa(id)+"]: "+err.Error(),
-				)
-				return
-			}
-
-			item.GroupPolicyID = m.GroupPolicyID
-			results = append(results, *item)
-			needsProvision.Add(*m.GroupPolicyID)
-		}
-
-		for id := range needsProvision.Iter() {
-			p := api.GroupPolicyProvision{
-				GroupPolicyID: &id,
-			}
-			_, err := api.CreateItem(r.ApiClient, p)
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					"Error provisioning item's group policy memberships",
-					"Unexpected response provisioning membership of item ID ["+*p.GroupPolicyID+"]: "+err.Error(),
-				)
-				return
-			}
+		results, err := applyGroupPolicyMemberships[api.GroupPolicyVaultAccount](ctx, r.ApiClient, id, gpList, nil)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error adding item's group policy memberships",
+				"Unexpected adding memberships of item ID ["+strconv.Itoa(id)+"]: "+err.Error(),
+			)
+			return
 		}
 
 		diags = resp.State.SetAttribute(ctx, path.Root("group_policy_memberships"), results)
@@ -254,7 +263,7 @@ func (r *vaultUsernamePasswordAccountResource) Create(ctx context.Context, req r
 
 func (r *vaultUsernamePasswordAccountResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	r.apiResource.Read(ctx, req, resp)
-	if resp.Diagnostics.HasError() {
+	if resp.Diagnostics.HasError() || resourceGone(resp) {
 		return
 	}
 	tflog.Debug(ctx, "🤬 User/Pass reading state")
@@ -324,63 +333,23 @@ func (r *vaultUsernamePasswordAccountResource) Read(ctx context.Context, req res
 
 	readJIA()
 
-	readGP := func() {
-		// Group Policy Memberships
-
-		var tfGPList types.Set
-		diags := req.State.GetAttribute(ctx, path.Root("group_policy_memberships"), &tfGPList)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-
-		if tfGPList.IsNull() {
-			return
-		}
-
-		var gpList []api.GroupPolicyVaultAccount
-		diags = tfGPList.ElementsAs(ctx, &gpList, false)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-
-		for i, m := range gpList {
-			m.AccountID = &id
-			tflog.Trace(ctx, "🌈 Reading item", map[string]interface{}{
-				"read": m,
-			})
-			gpId := *m.GroupPolicyID
-
-			endpoint := fmt.Sprintf("%s/%d", m.Endpoint(), id)
-			item, err := api.GetItemEndpoint[api.GroupPolicyVaultAccount](r.ApiClient, endpoint)
-
-			if err != nil {
-				tflog.Trace(ctx, "🌈 Error reading item item, skipping", map[string]interface{}{
-					"read":  m,
-					"error": err,
-				})
-			} else if item != nil {
-				tflog.Trace(ctx, "🌈 Read item", map[string]interface{}{
-					"read": *item,
-				})
-				item.GroupPolicyID = &gpId
-				gpList[i] = *item
-			}
-		}
-
-		diags = resp.State.SetAttribute(ctx, path.Root("group_policy_memberships"), gpList)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-	}
-
-	readGP()
+	readGroupPolicyMemberships[api.GroupPolicyVaultAccount](ctx, r.ApiClient, req.State, resp, id)
 }
 
 func (r *vaultUsernamePasswordAccountResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	writeOnly, diags := writeOnlyValue(ctx, req.Config, req.Plan, &req.State, "password_wo")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !writeOnly.IsNull() {
+		resp.Diagnostics.Append(req.Plan.SetAttribute(ctx, path.Root("password"), writeOnly)...)
+	}
+
 	r.apiResource.Update(ctx, req, resp)
+	if !writeOnly.IsNull() && !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("password"), types.StringNull())...)
+	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -417,6 +386,14 @@ func (r *vaultUsernamePasswordAccountResource) Update(ctx context.Context, req r
 		}
 		stateIsGone := tfStateObj.IsNull() || tfStateObj.IsUnknown()
 
+		if tfObj.IsUnknown() {
+			// Not configured, so the association is left as it is. Its entries may be managed
+			// by the standalone Jump Item association resources
+			diags = resp.State.SetAttribute(ctx, path.Root("jump_item_association"), tfStateObj)
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+
 		apiSub.ID = &id
 		tflog.Debug(ctx, fmt.Sprintf("🤷🏻‍♂️ Updating User/Pass Jump Associations with ID %d [%s]", *apiSub.ID, apiSub.Endpoint()), map[string]interface{}{
 			"data":           apiSub,
@@ -510,75 +487,16 @@ func (r *vaultUsernamePasswordAccountResource) Update(ctx context.Context, req r
 			return
 		}
 
-		toAdd, toRemove, noChange := api.DiffGPAccountLists(gpList, stateGPList)
-
-		tflog.Trace(ctx, "🌈 Updating group policy memberships", map[string]interface{}{
-			"add":    toAdd,
-			"remove": toRemove,
-
-			"tf":    tfGPList,
-			"list":  gpList,
-			"state": stateGPList,
-		})
-
-		// Shared with vault_ssh_account
-		accountMembershipMutex.Lock()
-		defer accountMembershipMutex.Unlock()
-
-		needsProvision := mapset.NewSet[string]()
-		for m := range toRemove.Iterator().C {
-			m.AccountID = &id
-			tflog.Trace(ctx, "🌈 Deleting item", map[string]interface{}{
-				"add":     m,
-				"gp":      m.GroupPolicyID,
-				"account": m.AccountID,
-			})
-			endpoint := fmt.Sprintf("%s/%d", m.Endpoint(), *m.AccountID)
-			err := api.DeleteItemEndpoint[api.GroupPolicyVaultAccount](r.ApiClient, endpoint)
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					"Error updating item's group policy memberships",
-					"Unexpected deleting membership of item ID ["+strconv.Itoa(id)+"]: "+err.Error(),
-				)
-				return
-			}
-			needsProvision.Add(*m.GroupPolicyID)
-		}
-
-		results := noChange.ToSlice()
-		for m := range toAdd.Iterator().C {
-			m.AccountID = &id
-			item, err := api.CreateItem(r.ApiClient, m)
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					"Error updating item's group policy memberships",
-					"Unexpected adding membership of item ID ["+strconv.Itoa(id)+"]: "+err.Error(),
-				)
-				return
-			}
-			item.GroupPolicyID = m.GroupPolicyID
-			results = append(results, *item)
-			needsProvision.Add(*m.GroupPolicyID)
-		}
-
-		for id := range needsProvision.Iter() {
-			p := api.GroupPolicyProvision{
-				GroupPolicyID: &id,
-			}
-			_, err := api.CreateItem(r.ApiClient, p)
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					"Error provisioning item's group policy memberships",
-					"Unexpected response provisioning membership of item ID ["+*p.GroupPolicyID+"]: "+err.Error(),
-				)
-				return
-			}
+		results, err := applyGroupPolicyMemberships[api.GroupPolicyVaultAccount](ctx, r.ApiClient, id, gpList, stateGPList)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating item's group policy memberships",
+				"Unexpected updating memberships of item ID ["+strconv.Itoa(id)+"]: "+err.Error(),
+			)
+			return
 		}
 
-		diags = resp.State.SetAttribute(ctx, path.Root("group_policy_memberships"), results)
+		diags = setGroupPolicyMemberships(ctx, tfGPList, &resp.State, results)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
 			return
@@ -33,8 +33,11 @@ type webJumpResource struct {
 	apiResource[api.WebJump, models.WebJump]
 }
 
+const webJumpSchemaVersion int64 = 1
+
 func (r *webJumpResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: webJumpSchemaVersion,
 		Description: `Manages a Web Jump Item.
 
 NOTE: Web Jump is PRA only.
@@ -47,6 +50,8 @@ For descriptions of individual fields, please see the Configuration API document
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"copy_from_id":   copyFromIDSchema(),
+			"adopt_existing": adoptExistingSchema(),
 			"name": schema.StringAttribute{
 				Required: true,
 			},
@@ -117,3 +122,7 @@ For descriptions of individual fields, please see the Configuration API document
 		},
 	}
 }
+
+func (r *webJumpResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(webJumpSchemaVersion, nil)
+}
@@ -0,0 +1,35 @@
+package rs
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Write-only attributes are never part of the plan or state, so their values have to be read from the
+// config. Each one has a matching "<name>_version" attribute; the value is only sent to the API when the
+// resource is created or when that version changes, since there is nothing in the state to compare the
+// value itself with. priorState is nil when creating the resource.
+//
+// Returns a null value when nothing should be sent.
+func writeOnlyValue(ctx context.Context, config tfsdk.Config, plan tfsdk.Plan, priorState *tfsdk.State, attr string) (types.String, diag.Diagnostics) {
+	var value types.String
+	diags := config.GetAttribute(ctx, path.Root(attr), &value)
+	if diags.HasError() || value.IsNull() || value.IsUnknown() {
+		return types.StringNull(), diags
+	}
+
+	if priorState != nil {
+		var planVersion, stateVersion types.Int64
+		diags.Append(plan.GetAttribute(ctx, path.Root(attr+"_version"), &planVersion)...)
+		diags.Append(priorState.GetAttribute(ctx, path.Root(attr+"_version"), &stateVersion)...)
+		if diags.HasError() || planVersion.Equal(stateVersion) {
+			return types.StringNull(), diags
+		}
+	}
+
+	return value, diags
+}
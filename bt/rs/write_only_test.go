@@ -0,0 +1,100 @@
+package rs
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"terraform-provider-sra/api"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteOnlyValue(t *testing.T) {
+	ctx := context.Background()
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"password_wo":         schema.StringAttribute{Optional: true, WriteOnly: true},
+			"password_wo_version": schema.Int64Attribute{Optional: true},
+		},
+	}
+	objType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"password_wo":         tftypes.String,
+		"password_wo_version": tftypes.Number,
+	}}
+	raw := func(password interface{}, version interface{}) tftypes.Value {
+		return tftypes.NewValue(objType, map[string]tftypes.Value{
+			"password_wo":         tftypes.NewValue(tftypes.String, password),
+			"password_wo_version": tftypes.NewValue(tftypes.Number, version),
+		})
+	}
+
+	config := tfsdk.Config{Schema: s, Raw: raw("hunter2", 2)}
+	plan := tfsdk.Plan{Schema: s, Raw: raw(nil, 2)}
+
+	// Always sent on create
+	value, diags := writeOnlyValue(ctx, config, plan, nil, "password_wo")
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "hunter2", value.ValueString())
+
+	// Not sent when the version hasn't changed
+	state := tfsdk.State{Schema: s, Raw: raw(nil, 2)}
+	value, diags = writeOnlyValue(ctx, config, plan, &state, "password_wo")
+	assert.False(t, diags.HasError())
+	assert.True(t, value.IsNull())
+
+	// Sent when the version changes
+	state = tfsdk.State{Schema: s, Raw: raw(nil, 1)}
+	value, diags = writeOnlyValue(ctx, config, plan, &state, "password_wo")
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "hunter2", value.ValueString())
+
+	// Nothing to send when the write-only attribute isn't used
+	config = tfsdk.Config{Schema: s, Raw: raw(nil, nil)}
+	value, diags = writeOnlyValue(ctx, config, plan, nil, "password_wo")
+	assert.False(t, diags.HasError())
+	assert.True(t, value.IsNull())
+}
+
+func TestWriteOnlyCreateFailure(t *testing.T) {
+	ctx := context.Background()
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if strings.HasSuffix(r.URL.Path, "oauth2/token") {
+			_, err := w.Write([]byte(`{"token_type":"Bearer","expires_in":3600,"access_token":"secret_access_granted"}`))
+			assert.Nil(t, err)
+			return
+		}
+		w.WriteHeader(http.StatusUnprocessableEntity)
+	}))
+	defer ts.Close()
+
+	clientID := "id"
+	clientSecret := "🤐"
+	c, err := api.NewClient(ts.URL, &clientID, &clientSecret)
+	assert.Nil(t, err)
+	c.SetTest(t)
+
+	r := newVaultTokenAccountResource().(*vaultTokenAccountResource)
+	r.ApiClient = c
+
+	attrs := map[string]any{"type": "opaque_token", "name": "ci", "token_wo": "hunter2"}
+	config, _ := testState(t, r, attrs)
+	delete(attrs, "token_wo")
+	plan, _ := testState(t, r, attrs)
+	empty, identity := testState(t, r, nil)
+
+	// A failed create doesn't leave a partial state behind
+	resp := resource.CreateResponse{State: empty, Identity: identity}
+	r.Create(ctx, resource.CreateRequest{
+		Config: tfsdk.Config{Schema: config.Schema, Raw: config.Raw},
+		Plan:   tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw},
+	}, &resp)
+	assert.True(t, resp.Diagnostics.HasError())
+	assert.True(t, resp.State.Raw.IsNull())
+}
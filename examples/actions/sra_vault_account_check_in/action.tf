@@ -0,0 +1,6 @@
+# Check in an account that the API account has checked out
+action "sra_vault_account_check_in" "done" {
+  config {
+    account_id = "123"
+  }
+}
@@ -0,0 +1,7 @@
+# Clear a stuck check out with
+#   terraform apply -invoke action.sra_vault_account_force_check_in.stuck
+action "sra_vault_account_force_check_in" "stuck" {
+  config {
+    account_id = "123"
+  }
+}
@@ -0,0 +1,19 @@
+# Rotate the account's password as soon as the account has been created
+action "sra_vault_account_rotate" "rotate" {
+  config {
+    account_id = sra_vault_username_password_account.new_account.id
+  }
+}
+
+resource "sra_vault_username_password_account" "new_account" {
+  name     = "Test User/Pass Account"
+  username = "test"
+  password = "initial-password"
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.sra_vault_account_rotate.rotate]
+    }
+  }
+}
@@ -0,0 +1,4 @@
+# List the users explicitly granted access to a Jump Group
+data "sra_jump_group_user_list" "example" {
+  jump_group_id = 1
+}
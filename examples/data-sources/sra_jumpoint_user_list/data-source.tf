@@ -0,0 +1,4 @@
+# List the users explicitly granted access to a Jumpoint
+data "sra_jumpoint_user_list" "example" {
+  jumpoint_id = 1
+}
@@ -0,0 +1,7 @@
+# List all discovered Windows Domain accounts
+data "sra_vault_domain_account_list" "all" {}
+
+# Filter by domain
+data "sra_vault_domain_account_list" "corp" {
+  domain = "corp.example.com"
+}
@@ -0,0 +1,7 @@
+# List all Vault Endpoints
+data "sra_vault_endpoint_list" "all" {}
+
+# Filter by domain
+data "sra_vault_endpoint_list" "filtered" {
+  domain_name = "example.local"
+}
@@ -0,0 +1,4 @@
+# List the Remote RDP Jump Items that can be associated with a Vault Endpoint
+data "sra_vault_endpoint_remote_rdp_candidate_list" "example" {
+  endpoint_id = 1
+}
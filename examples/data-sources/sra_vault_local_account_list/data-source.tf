@@ -0,0 +1,7 @@
+# List all discovered Windows Local accounts
+data "sra_vault_local_account_list" "all" {}
+
+# Filter by Endpoint
+data "sra_vault_local_account_list" "server" {
+  endpoint_id = 1
+}
@@ -0,0 +1,10 @@
+# Check out a database password for the duration of the apply
+ephemeral "sra_vault_secret" "db" {
+  id = "123"
+}
+
+provider "postgresql" {
+  host     = "db.example.local"
+  username = ephemeral.sra_vault_secret.db.username
+  password = ephemeral.sra_vault_secret.db.secret
+}
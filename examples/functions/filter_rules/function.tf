@@ -0,0 +1,13 @@
+resource "sra_network_tunnel_jump" "example" {
+  name          = "Example Network Tunnel"
+  jumpoint_id   = 1
+  jump_group_id = 1
+  filter_rules = provider::sra::filter_rules([
+    "tcp:10.0.0.0/24:443,8443",
+    "udp:10.0.1.10-10.0.1.20:1000-2000",
+    "*:10.0.2.5,10.0.2.6",
+    "tcp:[fd00::/64]:22",
+    "udp:10.0.3.0/24:*",
+    "ipv6_icmp:[fd00::/64]",
+  ])
+}
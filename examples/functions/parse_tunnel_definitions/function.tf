@@ -0,0 +1,4 @@
+# The remote ports tunneled by an existing Jump Item
+output "remote_ports" {
+  value = [for d in provider::sra::parse_tunnel_definitions(sra_protocol_tunnel_jump.tcp.tunnel_definitions) : d.remote_port]
+}
@@ -0,0 +1,11 @@
+# Forward local port 2222 to 22 and 8080 to 80 on the remote system
+resource "sra_protocol_tunnel_jump" "tcp" {
+  name          = "Example TCP Tunnel"
+  hostname      = "example.host"
+  jumpoint_id   = 1
+  jump_group_id = 1
+  tunnel_definitions = provider::sra::tunnel_definitions([
+    { local_port = 2222, remote_port = 22 },
+    { local_port = 8080, remote_port = 80 },
+  ])
+}
@@ -0,0 +1,9 @@
+# Find all Shell Jump Items in a Jump Group. Run `terraform query -generate-config-out=generated.tf`
+# to generate the resource configuration and import blocks for them.
+list "sra_shell_jump" "linux_servers" {
+  provider = sra
+
+  config {
+    jump_group_id = 3
+  }
+}
@@ -0,0 +1,8 @@
+# Find all Username/Password accounts in a Vault Account Group
+list "sra_vault_username_password_account" "service_accounts" {
+  provider = sra
+
+  config {
+    account_group_id = 2
+  }
+}
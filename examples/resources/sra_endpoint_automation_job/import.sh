@@ -0,0 +1,4 @@
+#!/usr/bin/env bash
+
+# Item can be imported by specifying the ID
+terraform import sra_endpoint_automation_job.example 123
\ No newline at end of file
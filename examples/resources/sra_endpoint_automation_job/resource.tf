@@ -0,0 +1,9 @@
+# Run a script on a set of Endpoint Automation Endpoints and wait for it to finish
+resource "sra_endpoint_automation_job" "example" {
+  name             = "Example Job"
+  operating_system = "Linux"
+  endpoint_ids     = [1, 2]
+  cmd              = "bash example.sh"
+  script           = "echo 'Hello from Terraform'"
+  timeout_minutes  = 15
+}
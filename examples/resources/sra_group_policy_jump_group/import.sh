@@ -0,0 +1,4 @@
+#!/usr/bin/env bash
+
+# Memberships can be imported by specifying the ID in the form <group_policy_id>/<jump_group_id>
+terraform import sra_group_policy_jump_group.example 1/2
\ No newline at end of file
@@ -0,0 +1,11 @@
+# The Jump Group is managed by another configuration
+data "sra_jump_group_list" "platform" {
+  code_name = "platform"
+}
+
+# Give an app team's Group Policy access to it
+resource "sra_group_policy_jump_group" "app_team" {
+  group_policy_id   = "5"
+  jump_group_id     = data.sra_jump_group_list.platform.items[0].id
+  jump_item_role_id = 2
+}
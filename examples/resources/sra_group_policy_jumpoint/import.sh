@@ -0,0 +1,4 @@
+#!/usr/bin/env bash
+
+# Memberships can be imported by specifying the ID in the form <group_policy_id>/<jumpoint_id>
+terraform import sra_group_policy_jumpoint.example 1/2
\ No newline at end of file
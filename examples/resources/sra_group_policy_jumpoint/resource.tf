@@ -0,0 +1,4 @@
+resource "sra_group_policy_jumpoint" "app_team" {
+  group_policy_id = "5"
+  jumpoint_id     = 1
+}
@@ -0,0 +1,5 @@
+resource "sra_group_policy_vault_account" "app_team" {
+  group_policy_id = "5"
+  account_id      = 21
+  role            = "inject"
+}
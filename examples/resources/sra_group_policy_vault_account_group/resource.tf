@@ -0,0 +1,5 @@
+resource "sra_group_policy_vault_account_group" "app_team" {
+  group_policy_id  = "5"
+  account_group_id = 3
+  role             = "inject_and_checkout"
+}
@@ -3,7 +3,17 @@ resource "sra_jump_group" "example" {
   name      = "Example Jump Group"
   code_name = "example_group"
 
+  # Remove any group policy memberships that aren't listed below
+  group_policy_membership_mode = "authoritative"
   group_policy_memberships = [
     { group_policy_id : "123", jump_item_role_id : 123, jump_policy_id : 123 }
   ]
 }
+
+# Take over the existing Jump Group with this code name, such as one created by a script,
+# instead of failing to create a duplicate
+resource "sra_jump_group" "adopted" {
+  adopt_existing = true
+  name           = "Existing Jump Group"
+  code_name      = "existing_group"
+}
@@ -0,0 +1,6 @@
+# Give a single user access to a Jump Group
+resource "sra_jump_group_user" "example" {
+  jump_group_id     = 1
+  user_id           = 2
+  jump_item_role_id = 3
+}
@@ -1,4 +1,7 @@
 #!/usr/bin/env bash
 
 # Item can be imported by specifying the ID
-terraform import sra_jumpoint.example 123
\ No newline at end of file
+terraform import sra_jumpoint.example 123
+
+# or by looking up its code name
+terraform import sra_jumpoint.example code_name:example
@@ -4,6 +4,9 @@ resource "sra_jumpoint" "example" {
   code_name = "example_jumpoint"
   platform  = "linux-x86"
 
+  # Deleting the Jumpoint deletes every Jump Item that uses it
+  deletion_protection = true
+
   group_policy_memberships = [
     { group_policy_id : "123" }
   ]
@@ -0,0 +1,7 @@
+import {
+  to = sra_jumpoint_user.example
+  identity = {
+    jumpoint_id = 1
+    user_id     = 2
+  }
+}
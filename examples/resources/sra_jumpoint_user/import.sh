@@ -0,0 +1,4 @@
+#!/usr/bin/env bash
+
+# Memberships can be imported by specifying the ID in the form <jumpoint_id>/<user_id>
+terraform import sra_jumpoint_user.example 1/2
\ No newline at end of file
@@ -0,0 +1,5 @@
+# Give a single user access to a Jumpoint
+resource "sra_jumpoint_user" "example" {
+  jumpoint_id = 1
+  user_id     = 2
+}
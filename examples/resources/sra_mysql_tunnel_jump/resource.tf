@@ -0,0 +1,16 @@
+# Manage example MySQL Tunnel Jump Item
+resource "sra_mysql_tunnel_jump" "example" {
+  name          = "Example MySQL Tunnel"
+  hostname      = "example.database"
+  jumpoint_id   = 1
+  jump_group_id = 1
+  username      = "db_user"
+  database      = "example"
+}
+
+# Items created with sra_protocol_tunnel_jump and tunnel_type = "mysql" can be
+# moved to this resource without re-creating them
+moved {
+  from = sra_protocol_tunnel_jump.example_mysql
+  to   = sra_mysql_tunnel_jump.example
+}
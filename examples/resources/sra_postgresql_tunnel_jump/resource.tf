@@ -0,0 +1,16 @@
+# Manage example PostgreSQL Tunnel Jump Item
+resource "sra_postgresql_tunnel_jump" "example" {
+  name          = "Example PostgreSQL Tunnel"
+  hostname      = "example.database"
+  jumpoint_id   = 1
+  jump_group_id = 1
+  username      = "db_user"
+  database      = "example"
+}
+
+# Items created with sra_protocol_tunnel_jump and tunnel_type = "psql" can be
+# moved to this resource without re-creating them
+moved {
+  from = sra_protocol_tunnel_jump.example_psql
+  to   = sra_postgresql_tunnel_jump.example
+}
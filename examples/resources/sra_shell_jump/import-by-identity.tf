@@ -0,0 +1,6 @@
+import {
+  to = sra_shell_jump.example
+  identity = {
+    id = "123"
+  }
+}
@@ -1,4 +1,8 @@
 #!/usr/bin/env bash
 
 # Item can be imported by specifying the ID
-terraform import sra_shell_jump.example 123
\ No newline at end of file
+terraform import sra_shell_jump.example 123
+
+# or by looking up its name, optionally within a Jump Group when the name is not unique
+terraform import sra_shell_jump.example name:example
+terraform import sra_shell_jump.example 3/name:example
@@ -5,3 +5,12 @@ resource "sra_shell_jump" "example" {
   jumpoint_id   = 1
   jump_group_id = 1
 }
+
+# Create a Shell Jump Item by copying an existing one, keeping its Vault associations
+resource "sra_shell_jump" "copy" {
+  copy_from_id  = sra_shell_jump.example.id
+  name          = "Example Shell Jump Copy"
+  hostname      = "other.host"
+  jumpoint_id   = 1
+  jump_group_id = 1
+}
@@ -0,0 +1,4 @@
+#!/usr/bin/env bash
+
+# Associations can be imported by specifying the ID in the form <account_group_id>/<jump_item_type>/<jump_item_id>
+terraform import sra_vault_account_group_jump_item_association.example 1/shell_jump/2
@@ -0,0 +1,5 @@
+resource "sra_vault_account_group_jump_item_association" "db" {
+  account_group_id = 2
+  jump_item_id     = 12
+  jump_item_type   = "shell_jump"
+}
@@ -0,0 +1,4 @@
+resource "sra_vault_account_group_shared_jump_group_association" "linux" {
+  account_group_id     = 2
+  shared_jump_group_id = 3
+}
@@ -0,0 +1,6 @@
+# Give a single user access to a Vault Account Group
+resource "sra_vault_account_group_user" "example" {
+  account_group_id = 1
+  user_id          = 2
+  role             = "inject_and_checkout"
+}
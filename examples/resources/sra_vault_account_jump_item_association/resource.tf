@@ -0,0 +1,13 @@
+resource "sra_shell_jump" "db" {
+  name          = "db"
+  hostname      = "db.example.local"
+  jumpoint_id   = 1
+  jump_group_id = 1
+}
+
+# Attach the Jump Item to a shared credential managed by another configuration
+resource "sra_vault_account_jump_item_association" "db" {
+  account_id     = 21
+  jump_item_id   = sra_shell_jump.db.id
+  jump_item_type = "shell_jump"
+}
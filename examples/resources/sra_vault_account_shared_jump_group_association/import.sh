@@ -0,0 +1,4 @@
+#!/usr/bin/env bash
+
+# Associations can be imported by specifying the ID in the form <account_id>/<shared_jump_group_id>
+terraform import sra_vault_account_shared_jump_group_association.example 1/2
\ No newline at end of file
@@ -0,0 +1,4 @@
+resource "sra_vault_account_shared_jump_group_association" "linux" {
+  account_id           = 21
+  shared_jump_group_id = 3
+}
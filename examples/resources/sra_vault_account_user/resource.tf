@@ -0,0 +1,6 @@
+# Give a single user access to a Vault Account
+resource "sra_vault_account_user" "example" {
+  account_id = 1
+  user_id    = 2
+  role       = "inject_and_checkout"
+}
@@ -0,0 +1,4 @@
+#!/usr/bin/env bash
+
+# Associations can be imported by specifying the ID in the form <endpoint_id>/<jump_item_id>
+terraform import sra_vault_endpoint_remote_rdp_association.example 1/2
\ No newline at end of file
@@ -0,0 +1,16 @@
+data "sra_vault_endpoint_list" "server" {
+  hostname = "server.example.local"
+}
+
+resource "sra_remote_rdp" "server" {
+  name          = "Example Server"
+  hostname      = "server.example.local"
+  jumpoint_id   = 1
+  jump_group_id = 1
+}
+
+# Inject the Endpoint's discovered credentials into the RDP Jump Item
+resource "sra_vault_endpoint_remote_rdp_association" "server" {
+  endpoint_id  = data.sra_vault_endpoint_list.server.items[0].id
+  jump_item_id = sra_remote_rdp.server.id
+}
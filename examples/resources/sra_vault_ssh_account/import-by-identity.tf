@@ -0,0 +1,8 @@
+import {
+  to = sra_vault_ssh_account.example
+  identity = {
+    id      = "123"
+    product = "pra"
+    type    = "ssh"
+  }
+}
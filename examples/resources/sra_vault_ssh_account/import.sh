@@ -2,3 +2,6 @@
 
 # Item can be imported by specifying the ID
 terraform import sra_vault_ssh_account.example 123
+
+# or by looking up its name
+terraform import sra_vault_ssh_account.example name:example
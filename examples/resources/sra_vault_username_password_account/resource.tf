@@ -21,3 +21,13 @@ resource "sra_vault_username_password_account" "new_account" {
     ]
   }
 }
+
+# Keep the password out of the plan and state by using the write-only attribute.
+# Increment password_wo_version whenever the password should be sent again.
+
+resource "sra_vault_username_password_account" "write_only" {
+  name                = "Test Write-Only Account"
+  username            = "test"
+  password_wo         = "this-is-a-test-password-that-should-be-generated-somehow"
+  password_wo_version = 1
+}